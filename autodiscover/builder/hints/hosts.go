@@ -0,0 +1,99 @@
+package hints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/bus"
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddBuilder("hints", NewHostHints)
+}
+
+const (
+	// hints key, annotations look like co.elastic.mysqlbeat/<hint>
+	hintsKey = "mysqlbeat"
+
+	// hints values
+	hintEnabled            = "enabled"
+	hintPort               = "port"
+	hintUsername           = "username"
+	hintPassword           = "password"
+	hintQueryGroups        = "query_groups"
+	hintIncludeQueryGroups = "include_query_groups"
+	hintExcludeQueryGroups = "exclude_query_groups"
+)
+
+type hostHints struct {
+	logger *logp.Logger
+}
+
+// NewHostHints builds a hints builder that creates a monitored host config
+// for every discovered container that has mysqlbeat hints.
+func NewHostHints(cfg *common.Config) (autodiscover.Builder, error) {
+	return &hostHints{logger: logp.NewLogger("hints.builder")}, nil
+}
+
+// CreateConfig creates the host config of a discovered container
+func (h *hostHints) CreateConfig(event bus.Event) []*common.Config {
+	hints, ok := event["hints"].(common.MapStr)
+	if !ok {
+		return nil
+	}
+
+	if _, err := hints.GetValue(hintsKey); err != nil {
+		return nil
+	}
+
+	if strings.ToLower(builder.GetHintString(hints, hintsKey, hintEnabled)) == "false" {
+		return nil
+	}
+
+	host, _ := event["host"].(string)
+	if host == "" {
+		return nil
+	}
+
+	// The port hint is required when the container has several ports
+	port := builder.GetHintString(hints, hintsKey, hintPort)
+	if port == "" {
+		if p, ok := event["port"]; ok && fmt.Sprint(p) != "0" {
+			port = fmt.Sprint(p)
+		}
+	}
+	if port == "" {
+		h.logger.Debugf("Discovered container without a mysql port: %v", host)
+		return nil
+	}
+
+	hostConfig := common.MapStr{
+		"hostname": host,
+		"port":     port,
+	}
+
+	for _, key := range []string{hintUsername, hintPassword} {
+		if value := builder.GetHintString(hints, hintsKey, key); value != "" {
+			hostConfig[key] = value
+		}
+	}
+
+	for _, key := range []string{hintQueryGroups, hintIncludeQueryGroups, hintExcludeQueryGroups} {
+		if values := builder.GetHintAsList(hints, hintsKey, key); len(values) > 0 {
+			hostConfig[key] = values
+		}
+	}
+
+	cfg, err := common.NewConfigFrom(hostConfig)
+	if err != nil {
+		h.logger.Errorf("Error creating the host config from hints: %v", err)
+		return nil
+	}
+
+	h.logger.Debugf("Generated host config from hints: %v", host)
+	return []*common.Config{cfg}
+}
@@ -0,0 +1,73 @@
+package beater
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/cfgfile"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/anzot/mysqlbeat/config"
+
+	// Register the autodiscover providers and the hints builder
+	_ "github.com/anzot/mysqlbeat/autodiscover/builder/hints"
+	_ "github.com/elastic/beats/libbeat/autodiscover/providers/docker"
+	_ "github.com/elastic/beats/libbeat/autodiscover/providers/kubernetes"
+)
+
+// hostFactory creates a runner for every autodiscovered host config
+type hostFactory struct {
+	bt *Mysqlbeat
+}
+
+// hostRunner monitors an autodiscovered host while it runs
+type hostRunner struct {
+	bt *Mysqlbeat
+	h  *host
+}
+
+// Create creates the runner of an autodiscovered host
+func (f *hostFactory) Create(p beat.Pipeline, cfg *common.Config, meta *common.MapStrPointer) (cfgfile.Runner, error) {
+	var hc config.Host
+	if err := cfg.Unpack(&hc); err != nil {
+		return nil, fmt.Errorf("error reading autodiscovered host config: %v", err)
+	}
+
+	h, err := newHost(f.bt.config, hc)
+	if err != nil {
+		return nil, err
+	}
+	h.meta = meta
+
+	h.client, err = f.bt.connect(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hostRunner{bt: f.bt, h: h}, nil
+}
+
+// CheckConfig checks an autodiscovered host config
+func (f *hostFactory) CheckConfig(cfg *common.Config) error {
+	var hc config.Host
+	if err := cfg.Unpack(&hc); err != nil {
+		return fmt.Errorf("error reading autodiscovered host config: %v", err)
+	}
+
+	_, err := newHost(f.bt.config, hc)
+	return err
+}
+
+// Start adds the host to the monitored hosts
+func (r *hostRunner) Start() {
+	r.bt.addHost(discoverySourceAutodiscover, r.h)
+}
+
+// Stop removes the host from the monitored hosts
+func (r *hostRunner) Stop() {
+	r.bt.removeHost(discoverySourceAutodiscover, r.h)
+}
+
+func (r *hostRunner) String() string {
+	return fmt.Sprintf("mysqlbeat host %s", r.h)
+}
@@ -0,0 +1,35 @@
+package beater
+
+import (
+	"sync/atomic"
+
+	"github.com/elastic/beats/libbeat/beat"
+)
+
+// publish publishes a batch of events of a host, they are counted as pending
+// until the output acknowledges them
+func (bt *Mysqlbeat) publish(h *host, batch []beat.Event) {
+	if len(batch) == 0 {
+		return
+	}
+
+	metricEventsPending.Set(atomic.AddInt64(&bt.pending, int64(len(batch))))
+	metricEventsPublished.Add(int64(len(batch)))
+	h.client.PublishAll(batch)
+}
+
+// acked is the ACK callback of the hosts clients
+func (bt *Mysqlbeat) acked(n int) {
+	metricEventsPending.Set(atomic.AddInt64(&bt.pending, -int64(n)))
+}
+
+// backpressured reports whether the output is congested: more events than
+// max_pending_events are still waiting for their ACK
+func (bt *Mysqlbeat) backpressured() (pending int64, congested bool) {
+	pending = atomic.LoadInt64(&bt.pending)
+	if bt.config.MaxPendingEvents <= 0 {
+		return pending, false
+	}
+
+	return pending, pending >= int64(bt.config.MaxPendingEvents)
+}
@@ -0,0 +1,36 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+func TestBackpressure(t *testing.T) {
+	c := config.DefaultConfig
+	c.MaxPendingEvents = 10
+	bt := &Mysqlbeat{config: c}
+
+	bt.pending = 9
+	if _, congested := bt.backpressured(); congested {
+		t.Errorf("9 pending events: congested")
+	}
+
+	bt.pending = 10
+	if _, congested := bt.backpressured(); !congested {
+		t.Errorf("10 pending events: not congested")
+	}
+
+	bt.acked(4)
+	if pending, congested := bt.backpressured(); congested || pending != 6 {
+		t.Errorf("after the ACK: %d pending events, congested %v", pending, congested)
+	}
+
+	bt.config.MaxPendingEvents = 0
+	bt.pending = 1000000
+	if _, congested := bt.backpressured(); congested {
+		t.Errorf("unlimited: congested")
+	}
+}
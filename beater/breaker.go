@@ -0,0 +1,85 @@
+package beater
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+const (
+	// circuit_breaker.state values of the state change events
+	breakerStateOpen   = "open"
+	breakerStateClosed = "closed"
+)
+
+// circuitBreaker disables a query of a host after consecutive failures. The
+// open breaker lets a probe run once its timeout elapsed, the query is enabled
+// again when the probe succeeds. The breakers of a host are only used by its
+// collection.
+type circuitBreaker struct {
+	failures  int
+	open      bool
+	openUntil time.Time
+}
+
+// allow reports whether the query runs: the breaker is closed, or open with
+// its timeout elapsed
+func (b *circuitBreaker) allow(now time.Time) bool {
+	return !b.open || !now.Before(b.openUntil)
+}
+
+// success records a successful run, it returns true when the breaker closes
+func (b *circuitBreaker) success() (closed bool) {
+	b.failures = 0
+	closed = b.open
+	b.open = false
+	return closed
+}
+
+// failure records a failed run, it returns true when the breaker opens. A
+// failed probe keeps the breaker open for another timeout.
+func (b *circuitBreaker) failure(now time.Time, c config.CircuitBreaker) (opened bool) {
+	b.failures++
+	if b.open {
+		b.openUntil = now.Add(c.Timeout)
+		return false
+	}
+	if c.Failures <= 0 || b.failures < c.Failures {
+		return false
+	}
+
+	b.open = true
+	b.openUntil = now.Add(c.Timeout)
+	return true
+}
+
+// publishBreakerState publishes the state change event of the circuit breaker
+// of a query
+func (bt *Mysqlbeat) publishBreakerState(h *host, query config.Query, b *circuitBreaker, state string) {
+	if state == breakerStateOpen {
+		logp.Warn("Host %s query %v disabled after %d consecutive failures, probed again in %v", h, query.Name, b.failures, bt.config.CircuitBreaker.Timeout)
+	} else {
+		logp.Info("Host %s query %v enabled again", h, query.Name)
+	}
+
+	event, _ := bt.generateEmptyEvent(h, query.Type, time.Now())
+	if bt.config.ECS {
+		h.applyECS(event, queryDataset(query), false, 0)
+	} else {
+		setEventDataset(event, queryDataset(query))
+	}
+	event.Fields["circuit_breaker"] = common.MapStr{
+		"state":    state,
+		"failures": b.failures,
+	}
+	event.Fields["collection"] = common.MapStr{
+		"query": query.Name,
+		"cycle": bt.cycle,
+	}
+
+	bt.publish(h, []beat.Event{*event})
+}
@@ -0,0 +1,54 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	c := config.CircuitBreaker{Failures: 3, Timeout: time.Minute}
+	now := time.Now()
+	b := &circuitBreaker{}
+
+	for i := 0; i < 2; i++ {
+		if b.failure(now, c) {
+			t.Fatalf("failure %d: breaker opened", i+1)
+		}
+	}
+	if !b.failure(now, c) {
+		t.Fatalf("failure 3: breaker not opened")
+	}
+	if b.allow(now.Add(30 * time.Second)) {
+		t.Errorf("open breaker: query allowed")
+	}
+
+	// The failed probe keeps the breaker open
+	probe := now.Add(time.Minute)
+	if !b.allow(probe) {
+		t.Fatalf("timeout elapsed: probe not allowed")
+	}
+	if b.failure(probe, c) {
+		t.Errorf("failed probe: breaker opened again")
+	}
+	if b.allow(probe.Add(30 * time.Second)) {
+		t.Errorf("failed probe: query allowed")
+	}
+
+	if !b.success() {
+		t.Errorf("successful probe: breaker not closed")
+	}
+	if !b.allow(probe) || b.success() {
+		t.Errorf("closed breaker: query not allowed")
+	}
+
+	b = &circuitBreaker{}
+	for i := 0; i < 10; i++ {
+		if b.failure(now, config.CircuitBreaker{}) {
+			t.Fatalf("disabled breaker opened")
+		}
+	}
+}
@@ -0,0 +1,888 @@
+package beater
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+const (
+	// null_values values
+	nullValuesEmpty   = "empty"
+	nullValuesOmit    = "omit"
+	nullValuesNull    = "null"
+	nullValuesDefault = "default"
+
+	// decimals values
+	decimalsFloat  = "float"
+	decimalsString = "string"
+	decimalsScaled = "scaled"
+
+	// scaleSuffix is appended to the name of the fields holding the scale of
+	// the scaled decimals
+	scaleSuffix = "_scale"
+
+	// json values
+	jsonObject = "object"
+	jsonString = "string"
+
+	// binary values
+	binaryString = "string"
+	binarySkip   = "skip"
+	binaryHex    = "hex"
+	binaryBase64 = "base64"
+	binaryLength = "length"
+
+	// durations values
+	durationsString  = "string"
+	durationsSeconds = "seconds"
+
+	// timestamp_format values, other formats are Go time layouts
+	timestampFormatUnix   = "unix"
+	timestampFormatUnixMs = "unix_ms"
+
+	// column_names values
+	columnNamesOriginal  = "original"
+	columnNamesLowercase = "lowercase"
+	columnNamesSnakeCase = "snake_case"
+
+	// non_finite values
+	nonFiniteDrop = "drop"
+	nonFiniteNull = "null"
+	nonFiniteZero = "zero"
+)
+
+// columnOptions are the column values settings of a query
+type columnOptions struct {
+	nullValues  string
+	nullDefault string
+
+	// decimals is the DECIMAL columns conversion, decimalColumns overrides it per column
+	decimals       string
+	decimalColumns map[string]string
+
+	// booleans converts the ON/OFF, YES/NO and TRUE/FALSE text values, and the
+	// 0/1 values of booleanColumns, to booleans
+	booleans       bool
+	booleanColumns map[string]bool
+
+	// json is the JSON columns conversion
+	json string
+
+	// binary is the binary columns conversion, binaryColumns overrides it per column
+	binary        string
+	binaryColumns map[string]string
+
+	// coerceNumerics parses the values without a known type as numbers when
+	// they look like numbers, stringColumns are always kept as strings
+	coerceNumerics bool
+	stringColumns  map[string]bool
+
+	// durations is the TIME columns conversion, the durationColumns hold
+	// durations (such as 00:02:31) converted to seconds whatever their type
+	durations       string
+	durationColumns map[string]bool
+
+	// geoPointColumns are the GEOMETRY columns holding points, and geoPoints
+	// the latitude and longitude columns, published as geo_points
+	geoPointColumns map[string]bool
+	geoPoints       []config.GeoPoint
+
+	// ipColumns hold IP addresses, the values that aren't addresses are dropped
+	ipColumns map[string]bool
+
+	// nonFinite is the handling of the NaN and infinite floats, which can't be
+	// encoded in JSON (divisions by zero, deltas of rows with the same age)
+	nonFinite string
+
+	// timestampColumn is the column holding the event timestamp, parsed with
+	// the timestampFormat in the timestampLocation (the server time zone when
+	// it's nil)
+	timestampColumn   string
+	timestampFormat   string
+	timestampLocation *time.Location
+
+	// documentIDColumns are the columns whose values, with the query name, are
+	// hashed into the event _id
+	documentIDColumns []string
+	queryName         string
+
+	// columnNames is the case of the event field names of the columns, the
+	// characters invalid in field names are replaced when sanitizeColumnNames
+	// is set
+	columnNames         string
+	sanitizeColumnNames bool
+
+	// batchBytes bounds the bytes of the rows of the multiple-rows events held
+	// before they are published
+	batchBytes int
+
+	// location is the time zone of the server temporal values
+	location *time.Location
+}
+
+// newColumnOptions returns the column values settings of a query, the query
+// settings that are left empty default to the global settings
+func newColumnOptions(c config.Config, query config.Query) (columnOptions, error) {
+	opts := columnOptions{
+		nullValues:          c.NullValues,
+		nullDefault:         c.NullDefault,
+		decimals:            c.Decimals,
+		booleans:            c.Booleans,
+		json:                c.JSON,
+		binary:              c.Binary,
+		coerceNumerics:      c.CoerceNumerics,
+		durations:           c.Durations,
+		nonFinite:           c.NonFinite,
+		columnNames:         c.ColumnNames,
+		sanitizeColumnNames: c.SanitizeColumnNames,
+		location:            time.UTC,
+	}
+
+	if query.NullValues != "" {
+		opts.nullValues = query.NullValues
+	}
+	if query.NullDefault != nil {
+		opts.nullDefault = *query.NullDefault
+	}
+	if query.Decimals != "" {
+		opts.decimals = query.Decimals
+	}
+	opts.decimalColumns = query.DecimalColumns
+	if query.Booleans != nil {
+		opts.booleans = *query.Booleans
+	}
+	if query.JSON != "" {
+		opts.json = query.JSON
+	}
+	if query.Binary != "" {
+		opts.binary = query.Binary
+	}
+	opts.binaryColumns = query.BinaryColumns
+	if query.CoerceNumerics != nil {
+		opts.coerceNumerics = *query.CoerceNumerics
+	}
+	if query.Durations != "" {
+		opts.durations = query.Durations
+	}
+	opts.durationColumns = map[string]bool{}
+	for _, column := range query.DurationColumns {
+		opts.durationColumns[column] = true
+	}
+	opts.geoPointColumns = map[string]bool{}
+	for _, column := range query.GeoPointColumns {
+		opts.geoPointColumns[column] = true
+	}
+	opts.geoPoints = query.GeoPoints
+	if query.NonFinite != "" {
+		opts.nonFinite = query.NonFinite
+	}
+	opts.batchBytes = c.PublishBatchBytes
+	if query.PublishBatchBytes > 0 {
+		opts.batchBytes = query.PublishBatchBytes
+	}
+	if query.ColumnNames != "" {
+		opts.columnNames = query.ColumnNames
+	}
+	opts.documentIDColumns = query.DocumentIDColumns
+	opts.queryName = query.Name
+	opts.timestampColumn = query.TimestampColumn
+	opts.timestampFormat = query.TimestampFormat
+	if query.TimestampTimezone != "" {
+		location, err := time.LoadLocation(query.TimestampTimezone)
+		if err != nil {
+			return opts, fmt.Errorf("invalid timestamp_timezone: %v", err)
+		}
+		opts.timestampLocation = location
+	}
+	opts.ipColumns = map[string]bool{}
+	for _, column := range query.IPColumns {
+		opts.ipColumns[column] = true
+	}
+	opts.stringColumns = map[string]bool{}
+	for _, column := range query.StringColumns {
+		opts.stringColumns[column] = true
+	}
+	opts.booleanColumns = map[string]bool{}
+	for _, column := range query.BooleanColumns {
+		opts.booleanColumns[column] = true
+	}
+
+	switch opts.nullValues {
+	case nullValuesEmpty, nullValuesOmit, nullValuesNull, nullValuesDefault:
+	default:
+		return opts, fmt.Errorf("unknown null_values: %v", opts.nullValues)
+	}
+
+	switch opts.json {
+	case jsonObject, jsonString:
+	default:
+		return opts, fmt.Errorf("unknown json: %v", opts.json)
+	}
+
+	for _, decimals := range append([]string{opts.decimals}, mapValues(opts.decimalColumns)...) {
+		switch decimals {
+		case decimalsFloat, decimalsString, decimalsScaled:
+		default:
+			return opts, fmt.Errorf("unknown decimals: %v", decimals)
+		}
+	}
+
+	switch opts.durations {
+	case durationsString, durationsSeconds:
+	default:
+		return opts, fmt.Errorf("unknown durations: %v", opts.durations)
+	}
+
+	switch opts.columnNames {
+	case columnNamesOriginal, columnNamesLowercase, columnNamesSnakeCase:
+	default:
+		return opts, fmt.Errorf("unknown column_names: %v", opts.columnNames)
+	}
+
+	switch opts.nonFinite {
+	case nonFiniteDrop, nonFiniteNull, nonFiniteZero:
+	default:
+		return opts, fmt.Errorf("unknown non_finite: %v", opts.nonFinite)
+	}
+
+	for _, binary := range append([]string{opts.binary}, mapValues(opts.binaryColumns)...) {
+		switch binary {
+		case binaryString, binarySkip, binaryHex, binaryBase64, binaryLength:
+		default:
+			return opts, fmt.Errorf("unknown binary: %v", binary)
+		}
+	}
+
+	return opts, nil
+}
+
+// columnDatabaseTypes returns the database type name of every column, the
+// name is empty when the driver doesn't report it
+func columnDatabaseTypes(rows *sql.Rows, columns []string) []string {
+	dbTypes := make([]string, len(columns))
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return dbTypes
+	}
+
+	for i, columnType := range columnTypes {
+		if i < len(dbTypes) {
+			dbTypes[i] = columnType.DatabaseTypeName()
+		}
+	}
+
+	return dbTypes
+}
+
+// columnKind returns the column type of a database type, known is false when
+// the database type doesn't say how to convert the values
+func columnKind(dbType string) (kind int, known bool) {
+	switch strings.TrimPrefix(strings.ToUpper(dbType), "UNSIGNED ") {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "BIGINT", "YEAR":
+		return columnTypeInt, true
+	case "FLOAT", "DOUBLE":
+		return columnTypeFloat, true
+	case "DECIMAL":
+		return columnTypeDecimal, true
+	case "DATE", "DATETIME", "TIMESTAMP", "JSON":
+		return columnTypeOther, true
+	case "BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB", "BIT", "GEOMETRY":
+		return columnTypeBinary, true
+	case "CHAR", "VARCHAR", "TINYTEXT", "TEXT", "MEDIUMTEXT", "LONGTEXT", "ENUM", "SET", "TIME":
+		return columnTypeString, true
+	}
+
+	return columnTypeString, false
+}
+
+// columnFieldType returns the Elasticsearch field type matching the values
+// published for a database type, an empty type when it depends on the values
+// (dynamic mapping). ENUM and SET values are always strings, mapped as keywords.
+func columnFieldType(dbType string) string {
+	switch strings.TrimPrefix(strings.ToUpper(dbType), "UNSIGNED ") {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "YEAR":
+		return "long"
+	case "BIGINT":
+		if strings.HasPrefix(strings.ToUpper(dbType), "UNSIGNED ") {
+			return "unsigned_long"
+		}
+		return "long"
+	case "FLOAT", "DOUBLE", "DECIMAL":
+		return "double"
+	case "DATE", "DATETIME", "TIMESTAMP":
+		return "date"
+	case "JSON":
+		return "object"
+	case "CHAR", "VARCHAR", "ENUM", "SET", "TIME":
+		return "keyword"
+	case "TINYTEXT", "TEXT", "MEDIUMTEXT", "LONGTEXT":
+		return "text"
+	case "BINARY", "VARBINARY", "TINYBLOB", "BLOB", "MEDIUMBLOB", "LONGBLOB", "BIT", "GEOMETRY":
+		return "binary"
+	}
+
+	return ""
+}
+
+// describeColumns describes the columns types, such as "state (ENUM: keyword)"
+func describeColumns(columns []string, dbTypes []string) string {
+	descriptions := make([]string, len(columns))
+	for i, column := range columns {
+		fieldType := columnFieldType(dbTypes[i])
+		if fieldType == "" {
+			fieldType = "dynamic"
+		}
+		descriptions[i] = fmt.Sprintf("%v (%v: %v)", column, dbTypes[i], fieldType)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// parseColumnValue converts a column value according to the database type of
+// the column, the values of columnTypeOther are returned in vColValue. The
+// values of unknown types (unless coerce_numerics is disabled), and of text
+// columns when numeric is set (delta columns), are parsed with the number
+// heuristics. The string_columns are never converted.
+func parseColumnValue(strColName, strColValue string, dbType string, numeric bool, opts columnOptions) (strColType int, nColValue int64, fColValue float64, vColValue interface{}) {
+	if opts.stringColumns[strColName] {
+		return columnTypeString, 0, 0, nil
+	}
+	if opts.ipColumns[strColName] {
+		if ip, ok := parseIP(strColValue, dbType); ok {
+			return columnTypeOther, 0, 0, ip
+		}
+		return columnTypeSkip, 0, 0, nil
+	}
+
+	strColType, nColValue, fColValue, vColValue = convertColumnValue(strColName, strColValue, dbType, numeric, opts)
+
+	// Delta columns are numbers
+	if !numeric {
+		if b, ok := parseBoolean(strColName, strColValue, strColType, nColValue, opts); ok {
+			return columnTypeOther, 0, 0, b
+		}
+	}
+
+	return strColType, nColValue, fColValue, vColValue
+}
+
+// convertColumnValue converts a column value according to its database type
+func convertColumnValue(strColName, strColValue string, dbType string, numeric bool, opts columnOptions) (strColType int, nColValue int64, fColValue float64, vColValue interface{}) {
+	if opts.durationColumns[strColName] || opts.durations == durationsSeconds && strings.ToUpper(dbType) == "TIME" {
+		if n, f, ok := parseDuration(strColValue); ok {
+			if f != float64(n) {
+				return columnTypeFloat, 0, f, nil
+			}
+			return columnTypeInt, n, f, nil
+		}
+	}
+
+	kind, known := columnKind(dbType)
+	if !known && !numeric && !opts.coerceNumerics {
+		return columnTypeString, 0, 0, nil
+	}
+	if !known || numeric && (kind == columnTypeString || kind == columnTypeBinary) {
+		strColType, nColValue, fColValue = parseValueHeuristics(strColValue)
+		if u, ok := parseBigUnsigned(strColValue); ok && !numeric {
+			return columnTypeOther, 0, 0, u
+		}
+		return strColType, nColValue, fColValue, nil
+	}
+
+	switch kind {
+	case columnTypeInt:
+		if n, err := strconv.ParseInt(strColValue, 10, 64); err == nil {
+			return columnTypeInt, n, float64(n), nil
+		}
+
+		// BIGINT UNSIGNED values above the int64 range, delta columns are
+		// computed from floats
+		if u, err := strconv.ParseUint(strColValue, 10, 64); err == nil && !numeric {
+			return columnTypeOther, 0, 0, u
+		}
+		if f, err := strconv.ParseFloat(strColValue, 64); err == nil {
+			return columnTypeFloat, 0, f, nil
+		}
+	case columnTypeFloat:
+		if f, err := strconv.ParseFloat(strColValue, 64); err == nil {
+			return columnTypeFloat, 0, f, nil
+		}
+	case columnTypeDecimal:
+		decimals := columnMode(opts.decimals, opts.decimalColumns, strColName)
+
+		// Delta columns are computed from floats
+		if numeric {
+			decimals = decimalsFloat
+		}
+
+		switch decimals {
+		case decimalsString:
+			return columnTypeString, 0, 0, nil
+		case decimalsScaled:
+			// The value has as many decimals as the column scale, 12.34 is 1234
+			if n, err := strconv.ParseInt(strings.Replace(strColValue, ".", "", 1), 10, 64); err == nil {
+				return columnTypeInt, n, float64(n), nil
+			}
+			return columnTypeString, 0, 0, nil
+		}
+
+		if f, err := strconv.ParseFloat(strColValue, 64); err == nil {
+			return columnTypeFloat, 0, f, nil
+		}
+	case columnTypeBinary:
+		if opts.geoPointColumns[strColName] {
+			if lat, lon, ok := parsePoint([]byte(strColValue)); ok {
+				return columnTypeOther, 0, 0, map[string]interface{}{"lat": lat, "lon": lon}
+			}
+		}
+
+		switch columnMode(opts.binary, opts.binaryColumns, strColName) {
+		case binarySkip:
+			return columnTypeSkip, 0, 0, nil
+		case binaryHex:
+			return columnTypeOther, 0, 0, hex.EncodeToString([]byte(strColValue))
+		case binaryBase64:
+			return columnTypeOther, 0, 0, base64.StdEncoding.EncodeToString([]byte(strColValue))
+		case binaryLength:
+			return columnTypeInt, int64(len(strColValue)), float64(len(strColValue)), nil
+		}
+	case columnTypeOther:
+		if strings.ToUpper(dbType) == "JSON" {
+			// Invalid documents are kept as strings
+			var document interface{}
+			if opts.json == jsonObject && json.Unmarshal([]byte(strColValue), &document) == nil {
+				return columnTypeOther, 0, 0, document
+			}
+			break
+		}
+
+		if t, ok := parseTemporal(strColValue, opts.location); ok {
+			return columnTypeOther, 0, 0, t
+		}
+	}
+
+	return columnTypeString, 0, 0, nil
+}
+
+// parseBoolean converts a flag to a boolean when booleans are enabled, ok is
+// false when the value isn't a flag. The display width of the integer columns
+// isn't reported by the driver, the tinyint(1) columns must be listed in the
+// boolean_columns of the query.
+func parseBoolean(strColName, strColValue string, strColType int, nColValue int64, opts columnOptions) (value bool, ok bool) {
+	if strColType == columnTypeInt && opts.booleanColumns[strColName] && (nColValue == 0 || nColValue == 1) {
+		return nColValue == 1, true
+	}
+
+	if strColType == columnTypeString && opts.booleans {
+		switch strings.ToUpper(strColValue) {
+		case "ON", "YES", "TRUE":
+			return true, true
+		case "OFF", "NO", "FALSE":
+			return false, true
+		}
+	}
+
+	return false, false
+}
+
+// parseDuration parses a [-]HHH:MM:SS[.ffffff] duration to seconds, n is the
+// whole seconds and f the seconds with the fractional part
+func parseDuration(strColValue string) (n int64, f float64, ok bool) {
+	value := strColValue
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, 0, false
+	}
+
+	hours, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, false
+	}
+	minutes, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil || minutes > 59 {
+		return 0, 0, false
+	}
+	seconds, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil || seconds < 0 || seconds >= 60 {
+		return 0, 0, false
+	}
+
+	f = float64(hours*3600+minutes*60) + seconds
+	if negative {
+		f = -f
+	}
+
+	return int64(f), f, true
+}
+
+// replaceNonFinite drops the NaN and infinite floats of the fields, or
+// replaces them by null or 0, according to the non_finite setting
+func replaceNonFinite(fields map[string]interface{}, nonFinite string) {
+	for key, value := range fields {
+		switch v := value.(type) {
+		case float64:
+			if !math.IsNaN(v) && !math.IsInf(v, 0) {
+				continue
+			}
+
+			switch nonFinite {
+			case nonFiniteNull:
+				fields[key] = nil
+			case nonFiniteZero:
+				fields[key] = float64(0)
+			default:
+				delete(fields, key)
+			}
+		case common.MapStr:
+			replaceNonFinite(v, nonFinite)
+		case map[string]interface{}:
+			replaceNonFinite(v, nonFinite)
+		}
+	}
+}
+
+// parseIP returns the address of an ip_columns value: a textual address
+// (INET_NTOA, INET6_NTOA), an address followed by a port such as the
+// processlist hosts (10.0.0.1:51234, [::1]:51234), or the bytes of an address
+// in a BINARY or VARBINARY column (INET6_ATON). ok is false for the other
+// values, such as host names: a 4 or 16 characters text isn't an address.
+func parseIP(strColValue string, dbType string) (string, bool) {
+	if ip := net.ParseIP(strColValue); ip != nil {
+		return ip.String(), true
+	}
+
+	if address, _, err := net.SplitHostPort(strColValue); err == nil {
+		if ip := net.ParseIP(address); ip != nil {
+			return ip.String(), true
+		}
+	}
+
+	switch strings.ToUpper(dbType) {
+	case "BINARY", "VARBINARY":
+		if len(strColValue) == net.IPv4len || len(strColValue) == net.IPv6len {
+			return net.IP(strColValue).String(), true
+		}
+	}
+
+	return "", false
+}
+
+// parsePoint parses a POINT in the MySQL internal geometry format: the SRID
+// (4 bytes) followed by the WKB of the geometry. The x coordinate is the
+// longitude, the y coordinate the latitude.
+func parsePoint(value []byte) (lat float64, lon float64, ok bool) {
+	// SRID, byte order, geometry type, x and y
+	if len(value) != 4+1+4+8+8 {
+		return 0, 0, false
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if value[4] == 0 {
+		order = binary.BigEndian
+	}
+
+	// 1 is the WKB point type
+	if order.Uint32(value[5:9]) != 1 {
+		return 0, 0, false
+	}
+
+	lon = math.Float64frombits(order.Uint64(value[9:17]))
+	lat = math.Float64frombits(order.Uint64(value[17:25]))
+
+	return lat, lon, true
+}
+
+// applyGeoPoints replaces the latitude and longitude fields of the event by
+// the geo_point fields, the fields must be numbers
+func applyGeoPoints(fields map[string]interface{}, geoPoints []config.GeoPoint) {
+	for _, point := range geoPoints {
+		lat, latOK := toFloat(fields[point.Lat])
+		lon, lonOK := toFloat(fields[point.Lon])
+		if !latOK || !lonOK {
+			continue
+		}
+
+		delete(fields, point.Lat)
+		delete(fields, point.Lon)
+		fields[point.Field] = map[string]interface{}{"lat": lat, "lon": lon}
+	}
+}
+
+// toFloat returns the value of a numeric field
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
+
+// temporalLayouts are the formats of the DATE, DATETIME and TIMESTAMP values
+var temporalLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// parseTemporal parses a temporal value in the server time zone, zero dates
+// (0000-00-00) are invalid and kept as strings
+func parseTemporal(strColValue string, location *time.Location) (time.Time, bool) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	for _, layout := range temporalLayouts {
+		if t, err := time.ParseInLocation(layout, strColValue, location); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// documentID returns the event _id of a row: the SHA-256 of the query name and
+// the values of the document_id_columns, ok is false when a column is missing
+func documentID(queryName string, columns []string, values map[string]string) (id string, ok bool) {
+	hash := sha256.New()
+	hash.Write([]byte(queryName))
+	for _, column := range columns {
+		value, exists := values[column]
+		if !exists {
+			return "", false
+		}
+
+		// The values are length prefixed so that ("ab", "c") and ("a", "bc")
+		// have different ids
+		fmt.Fprintf(hash, "\x00%d:%s", len(value), value)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
+
+// fieldName returns the event field name of a column according to the
+// column_names and sanitize_column_names settings. The sanitized names only
+// hold letters, digits, underscores, dots and hyphens, the other characters
+// (such as the spaces and parentheses of COUNT(*) or "Total rows") are
+// replaced by underscores.
+func fieldName(name string, opts columnOptions) string {
+	if opts.sanitizeColumnNames {
+		name = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-' {
+				return r
+			}
+			return '_'
+		}, name)
+	}
+
+	switch opts.columnNames {
+	case columnNamesLowercase:
+		name = strings.ToLower(name)
+	case columnNamesSnakeCase:
+		name = snakeCase(name)
+	}
+
+	if opts.sanitizeColumnNames {
+		for strings.Contains(name, "__") {
+			name = strings.Replace(name, "__", "_", -1)
+		}
+		if trimmed := strings.Trim(name, "_"); trimmed != "" {
+			name = trimmed
+		}
+	}
+
+	return name
+}
+
+// expandDots moves the fields with dots in their names, such as the columns
+// aliased as innodb.buffer_pool.reads, to nested objects. A field that would
+// replace another field (innodb and innodb.reads) keeps its name. The fields
+// are moved shortest name first, so that a conflict (a.b and a.b.c) always
+// keeps the same field flat: the deepest one.
+func expandDots(fields common.MapStr) {
+	var keys []string
+	for key := range fields {
+		if strings.Contains(key, ".") && !strings.HasPrefix(key, ".") && !strings.HasSuffix(key, ".") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, key := range keys {
+		value := fields[key]
+		delete(fields, key)
+		if exists, _ := fields.HasKey(key); exists || !canPut(fields, key) {
+			fields[key] = value
+			continue
+		}
+		fields.Put(key, value)
+	}
+}
+
+// canPut checks that putting the dotted key doesn't replace a field that
+// isn't an object
+func canPut(fields common.MapStr, key string) bool {
+	parts := strings.Split(key, ".")
+	current := fields
+	for _, part := range parts[:len(parts)-1] {
+		value, exists := current[part]
+		if !exists {
+			return true
+		}
+
+		next, ok := value.(common.MapStr)
+		if !ok {
+			return false
+		}
+		current = next
+	}
+
+	return true
+}
+
+// snakeCase converts a name to snake case: Seconds_Behind_Master is
+// seconds_behind_master, SecondsBehindMaster and HTTPServer are
+// seconds_behind_master and http_server
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || unicode.IsUpper(previous) && nextIsLower {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// parseTimestamp parses the value of the timestamp_column: a DATETIME or
+// TIMESTAMP value by default, a Unix time in seconds or milliseconds, or a
+// value in the timestamp_format layout
+func parseTimestamp(strColValue string, opts columnOptions) (time.Time, bool) {
+	location := opts.timestampLocation
+	if location == nil {
+		location = opts.location
+	}
+
+	switch opts.timestampFormat {
+	case "":
+		return parseTemporal(strColValue, location)
+	case timestampFormatUnix, timestampFormatUnixMs:
+		f, err := strconv.ParseFloat(strColValue, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		if opts.timestampFormat == timestampFormatUnixMs {
+			f /= 1000
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*1e9)).UTC(), true
+	}
+
+	t, err := time.ParseInLocation(opts.timestampFormat, strColValue, location)
+	return t, err == nil
+}
+
+// parseValueHeuristics converts a value to an int64 or a float64 when it looks
+// like a number, otherwise it's kept as a string
+func parseValueHeuristics(strColValue string) (strColType int, nColValue int64, fColValue float64) {
+	strColType = columnTypeString
+
+	// Try to parse the value to an int64
+	nColValue, err := strconv.ParseInt(strColValue, 0, 64)
+	if err == nil {
+		strColType = columnTypeInt
+	}
+
+	// Try to parse the value to a float64, the NaN and Inf texts aren't numbers
+	fColValue, err = strconv.ParseFloat(strColValue, 64)
+	if err == nil && !math.IsNaN(fColValue) && !math.IsInf(fColValue, 0) {
+		// If it's not already an established int64, set type to float
+		if strColType == columnTypeString {
+			strColType = columnTypeFloat
+		}
+	}
+
+	return strColType, nColValue, fColValue
+}
+
+// decimalScale returns the scale of a DECIMAL value published as a scaled
+// integer, its number of decimals: 12.34 is published as 1234 with a scale
+// of 2. ok is false for the other columns.
+func decimalScale(strColName, strColValue string, dbType string, opts columnOptions) (scale int64, ok bool) {
+	if kind, _ := columnKind(dbType); kind != columnTypeDecimal {
+		return 0, false
+	}
+	if columnMode(opts.decimals, opts.decimalColumns, strColName) != decimalsScaled {
+		return 0, false
+	}
+
+	if i := strings.Index(strColValue, "."); i >= 0 {
+		return int64(len(strColValue) - i - 1), true
+	}
+
+	return 0, true
+}
+
+// columnMode returns the conversion mode of a column, from the modes by column
+// or the query mode
+func columnMode(mode string, columnModes map[string]string, strColName string) string {
+	if columnMode, exists := columnModes[strColName]; exists {
+		return columnMode
+	}
+
+	return mode
+}
+
+// parseBigUnsigned parses the unsigned integers above the int64 range, which
+// the heuristics would parse as floats
+func parseBigUnsigned(strColValue string) (uint64, bool) {
+	if _, err := strconv.ParseInt(strColValue, 10, 64); err == nil {
+		return 0, false
+	}
+
+	u, err := strconv.ParseUint(strColValue, 10, 64)
+	return u, err == nil
+}
+
+// mapValues returns the values of a map
+func mapValues(m map[string]string) []string {
+	var values []string
+	for _, value := range m {
+		values = append(values, value)
+	}
+
+	return values
+}
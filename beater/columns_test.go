@@ -0,0 +1,409 @@
+// +build !integration
+
+package beater
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+func TestParseColumnValue(t *testing.T) {
+	tests := []struct {
+		value    string
+		dbType   string
+		numeric  bool
+		expected int
+	}{
+		{"0123", "VARCHAR", false, columnTypeString},
+		{"5.7.30", "VARCHAR", false, columnTypeString},
+		{"0123", "VARCHAR", true, columnTypeInt},
+		{"0123", "INT", false, columnTypeInt},
+		{"18446744073709551615", "UNSIGNED BIGINT", false, columnTypeOther},
+		{"18446744073709551615", "UNSIGNED BIGINT", true, columnTypeFloat},
+		{"18446744073709551615", "", false, columnTypeOther},
+		{"1.5", "DECIMAL", false, columnTypeFloat},
+		{"42", "", false, columnTypeInt},
+		{"abc", "", false, columnTypeString},
+	}
+
+	for _, test := range tests {
+		strColType, _, _, _ := parseColumnValue("value", test.value, test.dbType, test.numeric, columnOptions{coerceNumerics: true})
+		if strColType != test.expected {
+			t.Errorf("%q (%v): expected column type %v, got %v", test.value, test.dbType, test.expected, strColType)
+		}
+	}
+
+	if _, _, _, vColValue := parseColumnValue("value", "18446744073709551615", "BIGINT", false, columnOptions{coerceNumerics: true}); vColValue != uint64(18446744073709551615) {
+		t.Errorf("expected 18446744073709551615, got %v", vColValue)
+	}
+
+	// Integer columns are decimal, the heuristics would read 0123 as octal
+	if _, n, _, _ := parseColumnValue("value", "0123", "INT", false, columnOptions{}); n != 123 {
+		t.Errorf("expected 123, got %v", n)
+	}
+}
+
+func TestParseColumnValueTemporal(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	strColType, _, _, vColValue := parseColumnValue("value", "2020-01-02 10:00:00.5", "DATETIME", false, columnOptions{location: location})
+	if strColType != columnTypeOther {
+		t.Fatalf("expected a time, got column type %v", strColType)
+	}
+
+	expected := time.Date(2020, 1, 2, 9, 0, 0, 500000000, time.UTC)
+	if ts, ok := vColValue.(time.Time); !ok || !ts.Equal(expected) {
+		t.Fatalf("expected %v, got %v", expected, vColValue)
+	}
+
+	// Zero dates aren't valid times
+	if strColType, _, _, _ := parseColumnValue("value", "0000-00-00", "DATE", false, columnOptions{}); strColType != columnTypeString {
+		t.Fatalf("expected a string, got column type %v", strColType)
+	}
+}
+
+func TestParseColumnValueDecimals(t *testing.T) {
+	opts := columnOptions{decimals: decimalsString, decimalColumns: map[string]string{"amount": decimalsScaled}}
+
+	if strColType, _, _, _ := parseColumnValue("rate", "0.10000000000000000001", "DECIMAL", false, opts); strColType != columnTypeString {
+		t.Fatalf("expected a string, got column type %v", strColType)
+	}
+
+	if strColType, n, _, _ := parseColumnValue("amount", "-12.34", "DECIMAL", false, opts); strColType != columnTypeInt || n != -1234 {
+		t.Fatalf("expected -1234, got %v (column type %v)", n, strColType)
+	}
+
+	// Delta columns are always floats
+	if strColType, _, f, _ := parseColumnValue("amount", "12.34", "DECIMAL", true, opts); strColType != columnTypeFloat || f != 12.34 {
+		t.Fatalf("expected 12.34, got %v (column type %v)", f, strColType)
+	}
+
+	// 12.34 and 1.234 only differ by their scale
+	for value, expected := range map[string]int64{"-12.34": 2, "1.234": 3, "12": 0} {
+		if scale, ok := decimalScale("amount", value, "DECIMAL", opts); !ok || scale != expected {
+			t.Errorf("%v: expected a scale of %d, got %d (%v)", value, expected, scale, ok)
+		}
+	}
+	if _, ok := decimalScale("rate", "0.1", "DECIMAL", opts); ok {
+		t.Errorf("expected no scale for the string decimals")
+	}
+}
+
+func TestParseColumnValueBooleans(t *testing.T) {
+	opts := columnOptions{booleans: true, booleanColumns: map[string]bool{"read_only": true}}
+
+	tests := []struct {
+		column, value, dbType string
+		expected              interface{}
+	}{
+		{"read_only", "1", "TINYINT", true},
+		{"read_only", "0", "TINYINT", false},
+		{"count", "1", "TINYINT", nil},
+		{"Value", "ON", "VARCHAR", true},
+		{"Value", "no", "VARCHAR", false},
+		{"Value", "maybe", "VARCHAR", nil},
+	}
+
+	for _, test := range tests {
+		strColType, _, _, vColValue := parseColumnValue(test.column, test.value, test.dbType, false, opts)
+		if test.expected == nil {
+			if strColType == columnTypeOther {
+				t.Errorf("%v=%q: expected no boolean, got %v", test.column, test.value, vColValue)
+			}
+		} else if strColType != columnTypeOther || vColValue != test.expected {
+			t.Errorf("%v=%q: expected %v, got %v", test.column, test.value, test.expected, vColValue)
+		}
+	}
+}
+
+func TestParseColumnValueJSON(t *testing.T) {
+	strColType, _, _, vColValue := parseColumnValue("doc", `{"a": {"b": 1}}`, "JSON", false, columnOptions{json: jsonObject})
+	document, ok := vColValue.(map[string]interface{})
+	if strColType != columnTypeOther || !ok || document["a"].(map[string]interface{})["b"] != 1.0 {
+		t.Fatalf("expected a JSON object, got %v", vColValue)
+	}
+
+	if strColType, _, _, _ := parseColumnValue("doc", `{"a": 1}`, "JSON", false, columnOptions{json: jsonString}); strColType != columnTypeString {
+		t.Fatalf("expected a string, got column type %v", strColType)
+	}
+}
+
+func TestParseColumnValueBinary(t *testing.T) {
+	opts := columnOptions{binary: binaryBase64, binaryColumns: map[string]string{"checksum": binaryHex, "data": binarySkip, "image": binaryLength}}
+
+	tests := map[string]interface{}{
+		"uuid":     "/wA=",
+		"checksum": "ff00",
+		"image":    int64(2),
+	}
+	for column, expected := range tests {
+		strColType, nColValue, _, vColValue := parseColumnValue(column, "\xff\x00", "VARBINARY", false, opts)
+		if strColType == columnTypeInt {
+			vColValue = nColValue
+		}
+		if vColValue != expected {
+			t.Errorf("%v: expected %v, got %v", column, expected, vColValue)
+		}
+	}
+
+	if strColType, _, _, _ := parseColumnValue("data", "\xff", "BLOB", false, opts); strColType != columnTypeSkip {
+		t.Errorf("expected the column to be skipped, got column type %v", strColType)
+	}
+}
+
+func TestParseColumnValueStrings(t *testing.T) {
+	opts := columnOptions{coerceNumerics: false, stringColumns: map[string]bool{"zip": true}}
+
+	if strColType, _, _, _ := parseColumnValue("zip", "01234", "INT", false, opts); strColType != columnTypeString {
+		t.Errorf("expected a string, got column type %v", strColType)
+	}
+	if strColType, _, _, _ := parseColumnValue("version", "8.0", "", false, opts); strColType != columnTypeString {
+		t.Errorf("expected a string, got column type %v", strColType)
+	}
+
+	// Delta columns are still numbers
+	if strColType, _, _, _ := parseColumnValue("Queries__DELTA", "10", "", true, opts); strColType != columnTypeInt {
+		t.Errorf("expected an int, got column type %v", strColType)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]float64{
+		"00:02:31":        151,
+		"838:59:59":       3020399,
+		"-01:00:00":       -3600,
+		"00:00:01.500000": 1.5,
+	}
+	for value, expected := range tests {
+		if _, f, ok := parseDuration(value); !ok || f != expected {
+			t.Errorf("%v: expected %v seconds, got %v (%v)", value, expected, f, ok)
+		}
+	}
+
+	for _, value := range []string{"", "12", "00:61:00", "a:b:c"} {
+		if _, _, ok := parseDuration(value); ok {
+			t.Errorf("%q: expected an invalid duration", value)
+		}
+	}
+
+	// TIME columns are only converted with durations: "seconds"
+	opts := columnOptions{durations: durationsSeconds}
+	if strColType, n, _, _ := parseColumnValue("elapsed", "00:02:31", "TIME", false, opts); strColType != columnTypeInt || n != 151 {
+		t.Errorf("expected 151 seconds, got %v (column type %v)", n, strColType)
+	}
+	if strColType, _, _, _ := parseColumnValue("elapsed", "00:02:31", "TIME", false, columnOptions{}); strColType != columnTypeString {
+		t.Errorf("expected a string, got column type %v", strColType)
+	}
+}
+
+func TestColumnFieldType(t *testing.T) {
+	tests := map[string]string{
+		"ENUM":            "keyword",
+		"SET":             "keyword",
+		"UNSIGNED BIGINT": "unsigned_long",
+		"INT":             "long",
+		"DATETIME":        "date",
+		"":                "",
+	}
+	for dbType, expected := range tests {
+		if fieldType := columnFieldType(dbType); fieldType != expected {
+			t.Errorf("%v: expected %v, got %v", dbType, expected, fieldType)
+		}
+	}
+
+	// ENUM and SET values that look like numbers stay strings
+	if strColType, _, _, _ := parseColumnValue("level", "1", "ENUM", false, columnOptions{coerceNumerics: true}); strColType != columnTypeString {
+		t.Errorf("expected a string, got column type %v", strColType)
+	}
+}
+
+func TestGeoPoints(t *testing.T) {
+	// POINT(2.35 48.85) with the SRID 4326 in the internal format
+	point := []byte{0xe6, 0x10, 0, 0, 1, 1, 0, 0, 0}
+	point = append(point, littleEndianFloat(2.35)...)
+	point = append(point, littleEndianFloat(48.85)...)
+
+	opts := columnOptions{geoPointColumns: map[string]bool{"location": true}}
+	_, _, _, vColValue := parseColumnValue("location", string(point), "GEOMETRY", false, opts)
+	if geoPoint, ok := vColValue.(map[string]interface{}); !ok || geoPoint["lat"] != 48.85 || geoPoint["lon"] != 2.35 {
+		t.Fatalf("expected a geo_point, got %v", vColValue)
+	}
+
+	fields := map[string]interface{}{"lat": 48.85, "lon": int64(2), "name": "paris"}
+	applyGeoPoints(fields, []config.GeoPoint{{Field: "location", Lat: "lat", Lon: "lon"}})
+	if _, exists := fields["lat"]; exists || fields["location"] == nil {
+		t.Fatalf("expected the location geo_point, got %v", fields)
+	}
+}
+
+func littleEndianFloat(f float64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(f))
+	return b
+}
+
+func TestIPColumns(t *testing.T) {
+	opts := columnOptions{ipColumns: map[string]bool{"host": true}}
+	for value, expected := range map[string]string{
+		"10.0.0.1":       "10.0.0.1",
+		"10.0.0.1:51234": "10.0.0.1",
+		"[::1]:51234":    "::1",
+	} {
+		strColType, _, _, vColValue := parseColumnValue("host", value, "VARCHAR", false, opts)
+		if strColType != columnTypeOther || vColValue != expected {
+			t.Errorf("%q: expected %v, got %v", value, expected, vColValue)
+		}
+	}
+
+	// The bytes of an address only in the binary columns
+	if strColType, _, _, vColValue := parseColumnValue("host", "\x0a\x00\x00\x02", "VARBINARY", false, opts); strColType != columnTypeOther || vColValue != "10.0.0.2" {
+		t.Errorf("binary address: expected 10.0.0.2, got %v", vColValue)
+	}
+
+	for _, value := range []string{"localhost", "db01", "web-server-01.lan"} {
+		if strColType, _, _, _ := parseColumnValue("host", value, "VARCHAR", false, opts); strColType != columnTypeSkip {
+			t.Errorf("expected the host name %v to be dropped", value)
+		}
+	}
+}
+
+func TestNonFinite(t *testing.T) {
+	if strColType, _, _ := parseValueHeuristics("NaN"); strColType != columnTypeString {
+		t.Errorf("expected NaN to be kept as a string")
+	}
+	if strColType, _, fColValue := parseValueHeuristics("1.5e+06"); strColType != columnTypeFloat || fColValue != 1500000 {
+		t.Errorf("expected 1500000, got %v", fColValue)
+	}
+
+	for nonFinite, expected := range map[string]interface{}{nonFiniteNull: nil, nonFiniteZero: float64(0)} {
+		fields := map[string]interface{}{"ratio": math.Inf(1)}
+		replaceNonFinite(fields, nonFinite)
+		if value, exists := fields["ratio"]; !exists || value != expected {
+			t.Errorf("%v: expected %v, got %v", nonFinite, expected, value)
+		}
+	}
+
+	fields := map[string]interface{}{"ratio": math.NaN(), "nested": map[string]interface{}{"ratio": math.NaN()}}
+	replaceNonFinite(fields, nonFiniteDrop)
+	if _, exists := fields["ratio"]; exists || len(fields["nested"].(map[string]interface{})) != 0 {
+		t.Errorf("expected the NaN values to be dropped, got %v", fields)
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	expected := time.Date(2020, 3, 1, 12, 30, 0, 0, time.UTC)
+	for _, test := range []struct {
+		value string
+		opts  columnOptions
+	}{
+		{"2020-03-01 12:30:00", columnOptions{location: time.UTC}},
+		{"2020-03-01 13:30:00", columnOptions{location: paris}},
+		{"1583065800", columnOptions{timestampFormat: timestampFormatUnix}},
+		{"1583065800000", columnOptions{timestampFormat: timestampFormatUnixMs}},
+		{"01/03/2020 13:30", columnOptions{timestampFormat: "02/01/2006 15:04", location: time.UTC, timestampLocation: paris}},
+	} {
+		ts, ok := parseTimestamp(test.value, test.opts)
+		if !ok || !ts.Equal(expected) {
+			t.Errorf("%v: expected %v, got %v", test.value, expected, ts)
+		}
+	}
+
+	if _, ok := parseTimestamp("yesterday", columnOptions{}); ok {
+		t.Errorf("expected an invalid timestamp")
+	}
+}
+
+func TestDocumentID(t *testing.T) {
+	columns := []string{"schema", "table"}
+	id, ok := documentID("sizes", columns, map[string]string{"schema": "shop", "table": "orders", "rows": "12"})
+	if !ok || len(id) != 64 {
+		t.Fatalf("expected a SHA-256 id, got %v", id)
+	}
+
+	if other, _ := documentID("sizes", columns, map[string]string{"schema": "shop", "table": "orders", "rows": "13"}); other != id {
+		t.Errorf("expected the same id for the same key values")
+	}
+	if other, _ := documentID("sizes", columns, map[string]string{"schema": "shopo", "table": "rders"}); other == id {
+		t.Errorf("expected different ids for different key values")
+	}
+	if other, _ := documentID("counts", columns, map[string]string{"schema": "shop", "table": "orders"}); other == id {
+		t.Errorf("expected different ids for different queries")
+	}
+
+	if _, ok := documentID("sizes", columns, map[string]string{"schema": "shop"}); ok {
+		t.Errorf("expected no id when a column is missing")
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		opts     columnOptions
+		expected string
+	}{
+		{"Seconds_Behind_Master", columnOptions{columnNames: columnNamesOriginal}, "Seconds_Behind_Master"},
+		{"Com_select", columnOptions{columnNames: columnNamesLowercase}, "com_select"},
+		{"Seconds_Behind_Master", columnOptions{columnNames: columnNamesSnakeCase}, "seconds_behind_master"},
+		{"SecondsBehindMaster", columnOptions{columnNames: columnNamesSnakeCase}, "seconds_behind_master"},
+		{"HTTPServer2Port", columnOptions{columnNames: columnNamesSnakeCase}, "http_server2_port"},
+		{"COUNT(*)", columnOptions{columnNames: columnNamesLowercase, sanitizeColumnNames: true}, "count"},
+		{"Total rows", columnOptions{columnNames: columnNamesOriginal, sanitizeColumnNames: true}, "Total_rows"},
+		{"innodb.buffer_pool.reads", columnOptions{columnNames: columnNamesSnakeCase, sanitizeColumnNames: true}, "innodb.buffer_pool.reads"},
+	} {
+		if name := fieldName(test.name, test.opts); name != test.expected {
+			t.Errorf("%v: expected %v, got %v", test.name, test.expected, name)
+		}
+	}
+}
+
+func TestExpandDots(t *testing.T) {
+	fields := common.MapStr{
+		"innodb.buffer_pool.reads": int64(12),
+		"innodb.buffer_pool.hits":  int64(30),
+		"threads":                  int64(4),
+		"threads.running":          int64(2),
+	}
+	expandDots(fields)
+
+	if value, err := fields.GetValue("innodb.buffer_pool.reads"); err != nil || value != int64(12) {
+		t.Errorf("expected the nested innodb.buffer_pool.reads field, got %v", fields)
+	}
+	if _, exists := fields["innodb.buffer_pool.hits"]; exists {
+		t.Errorf("expected the innodb.buffer_pool.hits field to be nested")
+	}
+	if fields["threads"] != int64(4) || fields["threads.running"] != int64(2) {
+		t.Errorf("expected the conflicting threads.running field to keep its name, got %v", fields)
+	}
+}
+
+func TestExpandDotsConflictingDepths(t *testing.T) {
+	// The result doesn't depend on the map iteration order
+	for i := 0; i < 20; i++ {
+		fields := common.MapStr{
+			"a.b":   int64(1),
+			"a.b.c": int64(2),
+		}
+		expandDots(fields)
+
+		if value, err := fields.GetValue("a.b"); err != nil || value != int64(1) {
+			t.Fatalf("expected the nested a.b field, got %v", fields)
+		}
+		if fields["a.b.c"] != int64(2) || len(fields) != 2 {
+			t.Fatalf("expected the conflicting a.b.c field to keep its name, got %v", fields)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package beater
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+// orderQueries returns the queries sorted so that every query runs after the
+// queries it depends on. The queries linked by their dependencies (a chain)
+// follow each other, so that a chain holds its connection only while it
+// runs. Queries without dependencies between them keep their configured order.
+func orderQueries(queries []config.Query) ([]config.Query, error) {
+	// Map every query name to its position in the configuration
+	names := make(map[string]int, len(queries))
+	for i, query := range queries {
+		if query.Name == "" {
+			continue
+		}
+
+		if _, exists := names[query.Name]; exists {
+			return nil, fmt.Errorf("duplicate query name: %v", query.Name)
+		}
+		names[query.Name] = i
+	}
+
+	// Count the unresolved dependencies of every query and keep the reverse edges
+	pending := make([]int, len(queries))
+	dependents := make([][]int, len(queries))
+	for i, query := range queries {
+		for _, dep := range query.DependsOn {
+			j, exists := names[dep]
+			if !exists {
+				return nil, fmt.Errorf("query #%d depends on unknown query: %v", i, dep)
+			}
+			if j == i {
+				return nil, fmt.Errorf("query %v depends on itself", dep)
+			}
+
+			pending[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	ordered := make([]config.Query, 0, len(queries))
+	done := make([]bool, len(queries))
+
+	// Repeatedly pick the first query (in configuration order) that has no pending dependencies
+	for len(ordered) < len(queries) {
+		next := -1
+		for i := range queries {
+			if !done[i] && pending[i] == 0 {
+				next = i
+				break
+			}
+		}
+
+		if next < 0 {
+			var cycle []string
+			for i, query := range queries {
+				if !done[i] {
+					cycle = append(cycle, queryLabel(i, query))
+				}
+			}
+			return nil, fmt.Errorf("query dependencies contain a cycle: %v", strings.Join(cycle, ", "))
+		}
+
+		done[next] = true
+		ordered = append(ordered, queries[next])
+		for _, i := range dependents[next] {
+			pending[i]--
+		}
+	}
+
+	// Group the chains, in the order of their first query
+	chains := queryChains(ordered)
+	grouped := make([]config.Query, 0, len(ordered))
+	for i := range ordered {
+		if chainStart(chains, i) != i {
+			continue
+		}
+		for j := i; j < len(ordered); j++ {
+			if chainStart(chains, j) == i {
+				grouped = append(grouped, ordered[j])
+			}
+		}
+	}
+
+	return grouped, nil
+}
+
+// chainStart returns the position of the first query of the chain of a query
+func chainStart(chains []int, i int) int {
+	if chains[i] < 0 {
+		return i
+	}
+
+	return chains[i]
+}
+
+// queryChains returns the chain of every query, the position of the first
+// query of its chain, or -1 for the queries without dependencies nor
+// dependents. The dependencies on queries missing from the list are ignored.
+func queryChains(queries []config.Query) []int {
+	names := make(map[string]int, len(queries))
+	for i, query := range queries {
+		if query.Name != "" {
+			names[query.Name] = i
+		}
+	}
+
+	// Link every query to the first query of its chain
+	first := make([]int, len(queries))
+	for i := range first {
+		first[i] = i
+	}
+	root := func(i int) int {
+		for first[i] != i {
+			i = first[i]
+		}
+		return i
+	}
+
+	linked := make([]bool, len(queries))
+	for i, query := range queries {
+		for _, dep := range query.DependsOn {
+			j, exists := names[dep]
+			if !exists {
+				continue
+			}
+
+			linked[i], linked[j] = true, true
+			a, b := root(i), root(j)
+			if a > b {
+				a, b = b, a
+			}
+			first[b] = a
+		}
+	}
+
+	chains := make([]int, len(queries))
+	for i := range chains {
+		chains[i] = -1
+		if linked[i] {
+			chains[i] = root(i)
+		}
+	}
+
+	return chains
+}
+
+// queryLabel returns the query name, or its position when it has no name
+func queryLabel(i int, query config.Query) string {
+	if query.Name != "" {
+		return query.Name
+	}
+
+	return fmt.Sprintf("#%d", i)
+}
@@ -0,0 +1,81 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+func TestOrderQueries(t *testing.T) {
+	queries := []config.Query{
+		{Name: "report", DependsOn: []string{"snapshot"}},
+		{Name: "other"},
+		{Name: "snapshot"},
+	}
+
+	ordered, err := orderQueries(queries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, query := range ordered {
+		names = append(names, query.Name)
+	}
+
+	expected := []string{"other", "snapshot", "report"}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("expected order %v, got %v", expected, names)
+		}
+	}
+}
+
+func TestOrderQueriesChains(t *testing.T) {
+	queries := []config.Query{
+		{Name: "set"},
+		{Name: "other"},
+		{Name: "report", DependsOn: []string{"set"}},
+		{Name: "last"},
+	}
+
+	ordered, err := orderQueries(queries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, query := range ordered {
+		names = append(names, query.Name)
+	}
+
+	// The queries of a chain follow each other
+	expected := []string{"set", "report", "other", "last"}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("expected order %v, got %v", expected, names)
+		}
+	}
+
+	chains := queryChains(ordered)
+	if chains[0] != 0 || chains[1] != 0 || chains[2] != -1 || chains[3] != -1 {
+		t.Errorf("expected the set and report chain, got %v", chains)
+	}
+}
+
+func TestOrderQueriesErrors(t *testing.T) {
+	tests := map[string][]config.Query{
+		"duplicate": {{Name: "a"}, {Name: "a"}},
+		"unknown":   {{Name: "a", DependsOn: []string{"b"}}},
+		"self":      {{Name: "a", DependsOn: []string{"a"}}},
+		"cycle":     {{Name: "a", DependsOn: []string{"b"}}, {Name: "b", DependsOn: []string{"a"}}},
+	}
+
+	for name, queries := range tests {
+		if _, err := orderQueries(queries); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
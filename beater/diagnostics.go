@@ -0,0 +1,48 @@
+package beater
+
+import (
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+// defaultDiagnosticsHost is the listen address of the diagnostics endpoint,
+// reachable from the local host only
+const defaultDiagnosticsHost = "localhost:6060"
+
+// startDiagnostics starts the HTTP listener of the pprof profiles and the
+// expvar variables, the returned server is closed when the beat stops
+func startDiagnostics(c config.Diagnostics) (*http.Server, error) {
+	host := c.Host
+	if host == "" {
+		host = defaultDiagnosticsHost
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	listener, err := net.Listen("tcp", host)
+	if err != nil {
+		return nil, err
+	}
+
+	server := &http.Server{Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logp.Err("Diagnostics endpoint: %v", err)
+		}
+	}()
+
+	logp.Info("Diagnostics endpoint listening on http://%s/debug/pprof/", listener.Addr())
+	return server, nil
+}
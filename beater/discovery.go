@@ -0,0 +1,360 @@
+package beater
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+const (
+	// discovery sources values
+	discoverySourceSRV          = "srv"
+	discoverySourceAutodiscover = "autodiscover"
+	discoverySourceFile         = "file"
+
+	defaultSRVRefresh      = 60 * time.Second
+	defaultHostsFileReload = 10 * time.Second
+	defaultReplicasRefresh = 60 * time.Second
+)
+
+// updateHosts replaces the hosts discovered by source with the given hosts.
+// Hosts that are still discovered with the same settings keep their state, new
+// or changed hosts are connected to the publisher pipeline and removed hosts
+// are closed after the running cycle.
+func (bt *Mysqlbeat) updateHosts(source string, hostConfigs []config.Host) {
+	bt.hostsMutex.Lock()
+	defer bt.hostsMutex.Unlock()
+
+	current := bt.discovered[source]
+	updated := make(map[string]*host, len(hostConfigs))
+
+	for _, hc := range hostConfigs {
+		h, err := newHost(bt.config, hc)
+		if err != nil {
+			logp.Err("Discovered host (%s) ignored: %v", source, err)
+			continue
+		}
+
+		key := h.String()
+		if existing, ok := current[key]; ok && reflect.DeepEqual(existing.config, h.config) {
+			updated[key] = existing
+			continue
+		}
+		if _, ok := updated[key]; ok {
+			continue
+		}
+
+		h.client, err = bt.connect(bt.pipeline)
+		if err != nil {
+			logp.Err("Discovered host %s (%s) ignored: %v", h, source, err)
+			continue
+		}
+
+		logp.Info("Discovered host %s (%s) added", h, source)
+		updated[key] = h
+	}
+
+	for key, h := range current {
+		if updated[key] != h {
+			logp.Info("Discovered host %s (%s) removed", h, source)
+			bt.removed = append(bt.removed, h)
+		}
+	}
+
+	bt.discovered[source] = updated
+}
+
+// addHost adds a host discovered by source
+func (bt *Mysqlbeat) addHost(source string, h *host) {
+	bt.hostsMutex.Lock()
+	defer bt.hostsMutex.Unlock()
+
+	if bt.discovered[source] == nil {
+		bt.discovered[source] = map[string]*host{}
+	}
+
+	logp.Info("Discovered host %s (%s) added", h, source)
+	bt.discovered[source][h.String()] = h
+}
+
+// removeHost removes a host discovered by source, it's closed after the
+// running cycle
+func (bt *Mysqlbeat) removeHost(source string, h *host) {
+	bt.hostsMutex.Lock()
+	defer bt.hostsMutex.Unlock()
+
+	if bt.discovered[source][h.String()] == h {
+		logp.Info("Discovered host %s (%s) removed", h, source)
+		delete(bt.discovered[source], h.String())
+	}
+	bt.removed = append(bt.removed, h)
+}
+
+// closeRemovedHosts closes the clients and the connections of the removed
+// hosts, once no cycle collects them
+func (bt *Mysqlbeat) closeRemovedHosts() {
+	bt.hostsMutex.Lock()
+	removed := bt.removed
+	bt.removed = nil
+	bt.hostsMutex.Unlock()
+
+	for _, h := range removed {
+		h.client.Close()
+		h.close()
+	}
+}
+
+// activeHosts returns the configured hosts and the currently discovered hosts
+func (bt *Mysqlbeat) activeHosts() []*host {
+	bt.hostsMutex.Lock()
+	defer bt.hostsMutex.Unlock()
+
+	hosts := append([]*host{}, bt.hosts...)
+	for _, discovered := range bt.discovered {
+		for _, h := range discovered {
+			hosts = append(hosts, h)
+		}
+	}
+
+	return hosts
+}
+
+// runSRVDiscovery resolves the configured SRV record every refresh period
+// until the beat is stopped.
+func (bt *Mysqlbeat) runSRVDiscovery() {
+	refresh := bt.config.SRV.Refresh
+	if refresh <= 0 {
+		refresh = defaultSRVRefresh
+	}
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+
+	for {
+		hostConfigs, err := lookupSRV(bt.config.SRV.Name)
+		if err != nil {
+			// Keep the previously discovered hosts on resolution failures
+			logp.Err("SRV record %s lookup failed: %v", bt.config.SRV.Name, err)
+		} else {
+			bt.updateHosts(discoverySourceSRV, hostConfigs)
+		}
+
+		select {
+		case <-bt.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lookupSRV resolves a DNS SRV record into hosts
+func lookupSRV(name string) ([]config.Host, error) {
+	_, records, err := net.LookupSRV("", "", name)
+	if err != nil {
+		return nil, err
+	}
+
+	var hostConfigs []config.Host
+	for _, record := range records {
+		hostConfigs = append(hostConfigs, config.Host{
+			Hostname: strings.TrimSuffix(record.Target, "."),
+			Port:     strconv.Itoa(int(record.Port)),
+		})
+	}
+
+	return hostConfigs, nil
+}
+
+// runHostsFileReload loads the configured hosts file every reload period
+// until the beat is stopped.
+func (bt *Mysqlbeat) runHostsFileReload() {
+	period := bt.config.HostsFile.ReloadPeriod
+	if period <= 0 {
+		period = defaultHostsFileReload
+	}
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		hostConfigs, err := loadHostsFile(bt.config.HostsFile.Path)
+		if err != nil {
+			// Keep the previously loaded hosts when the file is invalid
+			logp.Err("Hosts file %s loading failed: %v", bt.config.HostsFile.Path, err)
+		} else {
+			bt.updateHosts(discoverySourceFile, hostConfigs)
+		}
+
+		select {
+		case <-bt.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// loadHostsFile reads the hosts list of a YAML or JSON hosts file
+func loadHostsFile(path string) ([]config.Host, error) {
+	cfg, err := common.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Hosts []config.Host `config:"hosts"`
+	}
+	if err := cfg.Unpack(&file); err != nil {
+		return nil, err
+	}
+
+	return file.Hosts, nil
+}
+
+// runReplicaDiscovery lists the replicas of a primary host every replicas
+// refresh period until the beat is stopped. Replicas use the credentials of
+// the primary host and its replica query groups.
+func (bt *Mysqlbeat) runReplicaDiscovery(primary *host) {
+	source := "replicas of " + primary.String()
+
+	refresh := bt.config.ReplicasRefresh
+	if refresh <= 0 {
+		refresh = defaultReplicasRefresh
+	}
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+
+	// The lookups are canceled when the beat is stopped, a lookup lasts at
+	// most the refresh period
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-bt.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		lookupCtx, cancelLookup := context.WithTimeout(ctx, refresh)
+		hostConfigs, err := lookupReplicas(lookupCtx, primary)
+		cancelLookup()
+		if err != nil {
+			// Keep the previously discovered replicas when the primary is unreachable
+			logp.Err("Replicas of %s lookup failed: %v", primary, err)
+		} else {
+			bt.updateHosts(source, hostConfigs)
+		}
+
+		select {
+		case <-bt.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lookupReplicas lists the replicas connected to a primary host, they are
+// reached like the primary host (e.g. through its ssh tunnel or proxy). Replicas that
+// don't set report_host are found from the binlog dump threads, assuming they
+// listen on the primary port.
+func lookupReplicas(ctx context.Context, primary *host) ([]config.Host, error) {
+	db, err := sql.Open("mysql", primary.connString(nil))
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	addresses := map[string]string{}
+
+	rows, err := db.QueryContext(ctx, "SHOW SLAVE HOSTS")
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+
+	for rows.Next() {
+		values := make([]sql.RawBytes, len(columns))
+		scanArgs := make([]interface{}, len(values))
+		for i := range values {
+			scanArgs[i] = &values[i]
+		}
+
+		if err := rows.Scan(scanArgs...); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		var hostname, port string
+		for i, column := range columns {
+			switch column {
+			case "Host":
+				hostname = string(values[i])
+			case "Port":
+				port = string(values[i])
+			}
+		}
+
+		if hostname != "" {
+			addresses[hostname] = port
+		}
+	}
+	rows.Close()
+
+	rows, err = db.QueryContext(ctx, "SELECT HOST FROM information_schema.PROCESSLIST WHERE COMMAND IN ('Binlog Dump', 'Binlog Dump GTID')")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var address string
+		if err := rows.Scan(&address); err != nil {
+			return nil, err
+		}
+
+		// The processlist host is the client address of the replica
+		hostname := address
+		if h, _, err := net.SplitHostPort(address); err == nil {
+			hostname = h
+		}
+
+		if _, exists := addresses[hostname]; !exists {
+			addresses[hostname] = primary.config.Port
+		}
+	}
+
+	var hostConfigs []config.Host
+	for hostname, port := range addresses {
+		hostConfigs = append(hostConfigs, config.Host{
+			Hostname:          hostname,
+			Port:              port,
+			Username:          primary.config.Username,
+			Password:          primary.config.Password,
+			EncryptedPassword: primary.config.EncryptedPassword,
+			QueryGroups:       primary.config.ReplicaGroups,
+			SSHTunnel:         primary.config.SSHTunnel,
+			ProxyURL:          primary.config.ProxyURL,
+			Timezone:          primary.config.Timezone,
+			Charset:           primary.config.Charset,
+			Collation:         primary.config.Collation,
+		})
+	}
+
+	return hostConfigs, rows.Err()
+}
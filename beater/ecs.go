@@ -0,0 +1,103 @@
+package beater
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+// defaultQueryDataset is the dataset of the queries without a name
+const defaultQueryDataset = "query"
+
+// queryDataset returns the dataset of a query: its dataset setting (with or
+// without the mysql. prefix), or its name. The events data is published under
+// mysql.<dataset> in the ECS layout.
+func queryDataset(query config.Query) string {
+	if query.Dataset != "" {
+		return strings.TrimPrefix(query.Dataset, "mysql.")
+	}
+	if query.Name == "" {
+		return defaultQueryDataset
+	}
+
+	return query.Name
+}
+
+// setEventDataset sets the event.module and event.dataset fields of an event
+// in the legacy layout, the dashboards of the MySQL module and the
+// Observability UI recognize the data source from them
+func setEventDataset(event *beat.Event, dataset string) {
+	if event.Fields == nil {
+		return
+	}
+
+	event.Fields["event"] = common.MapStr{
+		"module":  "mysql",
+		"dataset": "mysql." + dataset,
+	}
+}
+
+// applyECS moves an event to the ECS layout. The data fields are moved under
+// mysql.<dataset>, unless nested is set (the module events are already nested
+// under mysql.<module>), and the legacy type, hostname and port fields are
+// replaced by the event, service and server fields (the instance identity is
+// published as service.name, service.id and service.version). The autodiscover metadata
+// stays at the root.
+func (h *host) applyECS(event *beat.Event, dataset string, nested bool, duration time.Duration) {
+	if event.Fields == nil {
+		return
+	}
+
+	root := common.MapStr{}
+	if h.meta != nil {
+		for key := range h.meta.Get() {
+			if value, exists := event.Fields[key]; exists {
+				root[key] = value
+				delete(event.Fields, key)
+			}
+		}
+	}
+
+	data := event.Fields
+	instance, _ := data["instance"].(common.MapStr)
+	delete(data, "type")
+	delete(data, "hostname")
+	delete(data, "port")
+	delete(data, "instance")
+
+	if nested {
+		root.DeepUpdate(data)
+	} else {
+		root.Put("mysql."+dataset, data)
+	}
+
+	root["event"] = common.MapStr{
+		"module":   "mysql",
+		"dataset":  "mysql." + dataset,
+		"duration": duration.Nanoseconds(),
+	}
+	service := common.MapStr{
+		"type": "mysql",
+	}
+	for key, field := range map[string]string{"name": "name", "server_uuid": "id", "version": "version"} {
+		if value, exists := instance[key]; exists {
+			service[field] = value
+		}
+	}
+	root["service"] = service
+
+	server := common.MapStr{
+		"address": h.config.Hostname,
+	}
+	if port, err := strconv.Atoi(h.config.Port); err == nil {
+		server["port"] = port
+	}
+	root["server"] = server
+
+	event.Fields = root
+}
@@ -0,0 +1,66 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+func TestQueryDataset(t *testing.T) {
+	for _, test := range []struct {
+		query    config.Query
+		expected string
+	}{
+		{config.Query{}, defaultQueryDataset},
+		{config.Query{Name: "threads"}, "threads"},
+		{config.Query{Name: "threads", Dataset: "status"}, "status"},
+		{config.Query{Dataset: "mysql.status"}, "status"},
+	} {
+		if dataset := queryDataset(test.query); dataset != test.expected {
+			t.Errorf("%+v: expected %v, got %v", test.query, test.expected, dataset)
+		}
+	}
+}
+
+func TestApplyECS(t *testing.T) {
+	h := &host{config: config.Host{Hostname: "db1", Port: "3306"}}
+	event := &beat.Event{Fields: common.MapStr{
+		"type":          queryTypeSingleRow,
+		"hostname":      "db1",
+		"port":          "3306",
+		"Threads_total": int64(12),
+		"instance":      common.MapStr{"name": "orders", "server_uuid": "b8c6a6d4", "version": "8.0.32"},
+	}}
+
+	h.applyECS(event, "threads", false, 2*time.Millisecond)
+
+	expected := map[string]interface{}{
+		"mysql.threads.Threads_total": int64(12),
+		"event.module":                "mysql",
+		"event.dataset":               "mysql.threads",
+		"event.duration":              int64(2000000),
+		"service.type":                "mysql",
+		"service.name":                "orders",
+		"service.id":                  "b8c6a6d4",
+		"service.version":             "8.0.32",
+		"server.address":              "db1",
+		"server.port":                 3306,
+	}
+	for key, value := range expected {
+		if v, err := event.Fields.GetValue(key); err != nil || v != value {
+			t.Errorf("%v: expected %v, got %v", key, value, v)
+		}
+	}
+
+	for _, key := range []string{"type", "hostname", "port", "instance"} {
+		if _, exists := event.Fields[key]; exists {
+			t.Errorf("expected the %v field to be removed", key)
+		}
+	}
+}
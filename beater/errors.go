@@ -0,0 +1,198 @@
+package beater
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const (
+	// error kinds, the error.type values of the error events
+	errorKindConnection = "connection"
+	errorKindQuery      = "query"
+	errorKindScan       = "scan"
+
+	// error sources, the collection fields naming the failed host, query or
+	// module
+	errorSourceHost   = "host"
+	errorSourceQuery  = "query"
+	errorSourceModule = "module"
+
+	// client error numbers of the connection errors, which aren't errors of
+	// the server: the server is unreachable, or the connection was lost
+	errConnHostError = 2003
+	errServerLost    = 2013
+
+	// sqlStateGeneral is the SQLSTATE of the errors without a specific one
+	sqlStateGeneral = "HY000"
+)
+
+// sqlStates are the SQLSTATE of the common server errors, used when the driver
+// doesn't keep the SQLSTATE of the error packets
+var sqlStates = map[uint16]string{
+	1022: "23000", // duplicate key
+	1040: "08004", // too many connections
+	1044: "42000", // access denied to the database
+	1045: "28000", // access denied, authentication failed
+	1049: "42000", // unknown database
+	1053: "08S01", // server shutdown in progress
+	1054: "42S22", // unknown column
+	1062: "23000", // duplicate entry
+	1064: "42000", // syntax error
+	1142: "42000", // table command denied
+	1143: "42000", // column command denied
+	1146: "42S02", // unknown table
+	1152: "08S01", // aborted connection
+	1213: "40001", // deadlock
+	1227: "42000", // specific privilege required
+	1317: "70100", // query interrupted
+	1370: "42000", // procedure command denied
+	1927: "70100", // connection killed
+}
+
+// errorCode returns the MySQL error number and SQLSTATE of an error, the
+// connection errors get the client error numbers
+func errorCode(err error) (code uint16, sqlState string, ok bool) {
+	cause := errorCause(err)
+	if mysqlErr, ok := cause.(*mysql.MySQLError); ok {
+		if sqlState = driverSQLState(mysqlErr); sqlState != "" {
+			return mysqlErr.Number, sqlState, true
+		}
+
+		sqlState, exists := sqlStates[mysqlErr.Number]
+		if !exists {
+			sqlState = sqlStateGeneral
+		}
+		return mysqlErr.Number, sqlState, true
+	}
+
+	if !isBrokenConn(cause) {
+		return 0, "", false
+	}
+	if opErr, ok := cause.(*net.OpError); ok && opErr.Op == "dial" {
+		return errConnHostError, sqlStateGeneral, true
+	}
+
+	return errServerLost, sqlStateGeneral, true
+}
+
+// driverSQLState returns the SQLSTATE of the error packet kept by the driver,
+// or "" when it isn't kept. go-sql-driver/mysql has the MySQLError.SQLState
+// field ([5]byte) since v1.6.0, it's read by reflection so that the beat still
+// builds with v1.5.0 and older, which don't have it.
+func driverSQLState(err *mysql.MySQLError) string {
+	field := reflect.ValueOf(err).Elem().FieldByName("SQLState")
+	if !field.IsValid() || field.Kind() != reflect.Array || field.Type().Elem().Kind() != reflect.Uint8 {
+		return ""
+	}
+
+	state := make([]byte, field.Len())
+	set := false
+	for i := range state {
+		state[i] = byte(field.Index(i).Uint())
+		set = set || state[i] != 0
+	}
+	if !set {
+		return ""
+	}
+
+	return string(state)
+}
+
+// collectError is an error of the collection of a host classified by its
+// kind, so that the logs and the error events tell the connectivity issues
+// ("couldn't connect") from the SQL issues ("query failed", "row scan failed")
+type collectError struct {
+	kind string
+	err  error
+}
+
+func (e *collectError) Error() string {
+	switch e.kind {
+	case errorKindConnection:
+		return fmt.Sprintf("couldn't connect: %v", e.err)
+	case errorKindScan:
+		return fmt.Sprintf("row scan failed: %v", e.err)
+	}
+
+	return fmt.Sprintf("query failed: %v", e.err)
+}
+
+// newCollectError classifies an error, the errors of broken connections are
+// connection errors whatever the collection step
+func newCollectError(kind string, err error) error {
+	if _, ok := err.(*collectError); ok {
+		return err
+	}
+	if isBrokenConn(err) {
+		kind = errorKindConnection
+	}
+
+	return &collectError{kind: kind, err: err}
+}
+
+// errorKind returns the kind of an error, the errors that aren't classified
+// are query errors
+func errorKind(err error) string {
+	if collectErr, ok := err.(*collectError); ok {
+		return collectErr.kind
+	}
+	if isBrokenConn(err) {
+		return errorKindConnection
+	}
+
+	return errorKindQuery
+}
+
+// errorCause returns the error a collection error classifies
+func errorCause(err error) error {
+	if collectErr, ok := err.(*collectError); ok {
+		return collectErr.err
+	}
+
+	return err
+}
+
+// errorFields returns the error fields of an error event: its kind, message,
+// MySQL error number and SQLSTATE
+func errorFields(err error) common.MapStr {
+	fields := common.MapStr{
+		"type":    errorKind(err),
+		"message": errorCause(err).Error(),
+	}
+	if code, sqlState, ok := errorCode(err); ok {
+		fields["code"] = code
+		fields["sqlstate"] = sqlState
+	}
+
+	return fields
+}
+
+// publishError publishes the error event of a failed host, query or module,
+// with the event type and dataset of its data events, so that the failures
+// are visible alongside the data gap
+func (bt *Mysqlbeat) publishError(h *host, source, eventType, dataset string, nested bool, name string, err error, duration time.Duration) {
+	if !bt.config.ErrorEvents {
+		return
+	}
+
+	event, _ := bt.generateEmptyEvent(h, eventType, time.Now())
+	if bt.config.ECS {
+		h.applyECS(event, dataset, nested, duration)
+	} else {
+		setEventDataset(event, dataset)
+	}
+	event.Fields["error"] = errorFields(err)
+	event.Fields["collection"] = common.MapStr{
+		source:  name,
+		"cycle": bt.cycle,
+	}
+
+	bt.publish(h, []beat.Event{*event})
+}
@@ -0,0 +1,99 @@
+// +build !integration
+
+package beater
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestErrorFields(t *testing.T) {
+	err := newCollectError(errorKindQuery, &mysql.MySQLError{Number: 1146, Message: "Table 'test.orders' doesn't exist"})
+	fields := errorFields(err)
+	if fields["type"] != errorKindQuery || fields["code"] != uint16(1146) || fields["sqlstate"] != "42S02" {
+		t.Errorf("MySQL error fields: %v", fields)
+	}
+	if fields["message"] != "Error 1146: Table 'test.orders' doesn't exist" {
+		t.Errorf("MySQL error message: %v", fields["message"])
+	}
+
+	fields = errorFields(newCollectError(errorKindScan, errors.New("sql: expected 2 destination arguments in Scan, not 3")))
+	if _, exists := fields["code"]; exists || fields["type"] != errorKindScan {
+		t.Errorf("scan error fields: %v", fields)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	for _, test := range []struct {
+		err      error
+		code     uint16
+		sqlState string
+	}{
+		{&mysql.MySQLError{Number: 1045}, 1045, "28000"},
+		{&mysql.MySQLError{Number: 3024}, 3024, "HY000"},
+		{&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, 2003, "HY000"},
+		{mysql.ErrInvalidConn, 2013, "HY000"},
+	} {
+		code, sqlState, ok := errorCode(newCollectError(errorKindQuery, test.err))
+		if !ok || code != test.code || sqlState != test.sqlState {
+			t.Errorf("%v: code %d, sqlstate %v", test.err, code, sqlState)
+		}
+	}
+
+	if _, _, ok := errorCode(errors.New("bad row")); ok {
+		t.Errorf("conversion error: code found")
+	}
+}
+
+func TestCollectError(t *testing.T) {
+	err := newCollectError(errorKindQuery, mysql.ErrInvalidConn)
+	if errorKind(err) != errorKindConnection {
+		t.Errorf("broken connection: %v error", errorKind(err))
+	}
+	if err.Error() != "couldn't connect: invalid connection" {
+		t.Errorf("broken connection message: %v", err)
+	}
+
+	// The errors of a restarted server are connection errors, the pool and
+	// the prepared statements are reset
+	for _, number := range []uint16{errServerShutdown, errUnknownStmtHandler, errConnectionKilled} {
+		err = newCollectError(errorKindQuery, &mysql.MySQLError{Number: number})
+		if errorKind(err) != errorKindConnection {
+			t.Errorf("MySQL error %d: %v error", number, errorKind(err))
+		}
+	}
+
+	// The errors are classified once, by their first collection step
+	err = newCollectError(errorKindConnection, newCollectError(errorKindScan, errors.New("bad row")))
+	if errorKind(err) != errorKindScan || err.Error() != "row scan failed: bad row" {
+		t.Errorf("classified error: %v error %v", errorKind(err), err)
+	}
+
+	if errorKind(errors.New("unknown")) != errorKindQuery {
+		t.Errorf("unclassified error: %v error", errorKind(errors.New("unknown")))
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	for _, err := range []error{
+		&mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"},
+		&mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded"},
+		newCollectError(errorKindQuery, mysql.ErrInvalidConn),
+	} {
+		if !isRetryable(err) {
+			t.Errorf("%v: not retryable", err)
+		}
+	}
+
+	for _, err := range []error{
+		&mysql.MySQLError{Number: 1146, Message: "Table 'test.orders' doesn't exist"},
+		errors.New("no rows"),
+	} {
+		if isRetryable(err) {
+			t.Errorf("%v: retryable", err)
+		}
+	}
+}
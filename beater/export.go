@@ -0,0 +1,114 @@
+package beater
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+// ExportQueries prints to w the queries of the config as they run, in JSON:
+// in the order of their dependencies, with the environment variables
+// expanded, the empty settings set to the global ones and the hosts running
+// them. The hosts discovered at run time (srv, hosts_file, autodiscover,
+// discover_replicas) aren't known.
+func ExportQueries(cfg *common.Config, w io.Writer) error {
+	bt, err := newCommandBeat(cfg, &beat.Beat{Publisher: &printPipeline{w: ioutil.Discard}})
+	if err != nil {
+		return err
+	}
+
+	queries := make([]common.MapStr, 0, len(bt.config.Queries))
+	for _, query := range bt.config.Queries {
+		resolved, err := common.NewConfigFrom(resolveQuery(bt.config, query))
+		if err != nil {
+			return err
+		}
+
+		fields := common.MapStr{}
+		if err := resolved.Unpack(&fields); err != nil {
+			return err
+		}
+
+		hosts := []string{}
+		for _, h := range append(append([]*host{}, bt.hosts...), bt.pool...) {
+			if h.runs(query) {
+				hosts = append(hosts, h.String())
+			}
+		}
+		fields["hosts"] = hosts
+
+		queries = append(queries, fields)
+	}
+
+	data, err := json.MarshalIndent(common.MapStr{"queries": queries}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// resolveQuery returns a query with its empty settings set to the global
+// ones, as it runs
+func resolveQuery(c config.Config, query config.Query) config.Query {
+	query.Group = queryGroup(query)
+	query.Dataset = queryDataset(query)
+	query.RateLimitPolicy = queryRateLimitPolicy(c, query)
+	if query.Timeout <= 0 {
+		query.Timeout = c.QueryTimeout
+	}
+	if query.PublishBatchBytes <= 0 {
+		query.PublishBatchBytes = c.PublishBatchBytes
+	}
+
+	if query.NullValues == "" {
+		query.NullValues = c.NullValues
+	}
+	if query.NullDefault == nil {
+		query.NullDefault = &c.NullDefault
+	}
+	if query.Decimals == "" {
+		query.Decimals = c.Decimals
+	}
+	if query.Booleans == nil {
+		query.Booleans = &c.Booleans
+	}
+	if query.JSON == "" {
+		query.JSON = c.JSON
+	}
+	if query.Binary == "" {
+		query.Binary = c.Binary
+	}
+	if query.CoerceNumerics == nil {
+		query.CoerceNumerics = &c.CoerceNumerics
+	}
+	if query.Durations == "" {
+		query.Durations = c.Durations
+	}
+	if query.NonFinite == "" {
+		query.NonFinite = c.NonFinite
+	}
+	if query.ColumnNames == "" {
+		query.ColumnNames = c.ColumnNames
+	}
+
+	return query
+}
+
+// runs reports whether a query of the config runs on the host
+func (h *host) runs(query config.Query) bool {
+	for _, q := range h.queries {
+		if q.Name == query.Name && q.SQL == query.SQL {
+			return true
+		}
+	}
+
+	return false
+}
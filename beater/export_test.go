@@ -0,0 +1,34 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+func TestResolveQuery(t *testing.T) {
+	c := config.DefaultConfig
+	c.QueryTimeout = 30 * time.Second
+
+	booleans := true
+	query := resolveQuery(c, config.Query{
+		Name:     "threads",
+		Type:     queryTypeSingleRow,
+		SQL:      "SELECT 1",
+		Decimals: "string",
+		Booleans: &booleans,
+	})
+
+	if query.Timeout != 30*time.Second || query.Group != defaultQueryGroup || query.Dataset != "threads" {
+		t.Errorf("resolved timeout %v, group %v, dataset %v", query.Timeout, query.Group, query.Dataset)
+	}
+	if query.Decimals != "string" || !*query.Booleans {
+		t.Errorf("query settings overridden: decimals %v, booleans %v", query.Decimals, *query.Booleans)
+	}
+	if query.NullValues != c.NullValues || query.RateLimitPolicy != c.RateLimitPolicy || *query.CoerceNumerics != c.CoerceNumerics {
+		t.Errorf("global settings not applied: %+v", query)
+	}
+}
@@ -0,0 +1,154 @@
+package beater
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+// fieldTypes are the Elasticsearch field types of the field_types hints
+var fieldTypes = map[string]bool{
+	"keyword":      true,
+	"text":         true,
+	"long":         true,
+	"integer":      true,
+	"short":        true,
+	"byte":         true,
+	"double":       true,
+	"float":        true,
+	"half_float":   true,
+	"scaled_float": true,
+	"boolean":      true,
+	"date":         true,
+	"ip":           true,
+	"geo_point":    true,
+	"object":       true,
+	"binary":       true,
+}
+
+// queryFieldTypes returns the field types of a query events, by field name:
+// the types that the column hints (string_columns, boolean_columns,
+// duration_columns, decimal_columns, binary_columns, ip_columns and the
+// geo_point columns) give to the columns, overridden by the field_types
+func queryFieldTypes(c config.Config, query config.Query) (map[string]string, error) {
+	opts, err := newColumnOptions(c, query)
+	if err != nil {
+		return nil, err
+	}
+
+	types := map[string]string{}
+	hint := func(column, fieldType string) {
+		types[fieldName(column, opts)] = fieldType
+	}
+
+	for _, column := range query.IPColumns {
+		hint(column, "ip")
+	}
+	for _, column := range query.GeoPointColumns {
+		hint(column, "geo_point")
+	}
+	for _, point := range query.GeoPoints {
+		types[point.Field] = "geo_point"
+	}
+	for column, decimals := range query.DecimalColumns {
+		switch decimals {
+		case decimalsFloat:
+			hint(column, "double")
+		case decimalsString:
+			hint(column, "keyword")
+		case decimalsScaled:
+			hint(column, "long")
+			types[fieldName(column, opts)+scaleSuffix] = "long"
+		}
+	}
+	for column, binary := range query.BinaryColumns {
+		switch binary {
+		case binaryHex, binaryBase64:
+			hint(column, "keyword")
+		case binaryLength:
+			hint(column, "long")
+		}
+	}
+	for _, column := range query.DurationColumns {
+		hint(column, "double")
+	}
+	for _, column := range query.BooleanColumns {
+		hint(column, "boolean")
+	}
+
+	// The string_columns are never converted
+	for _, column := range query.StringColumns {
+		hint(column, "keyword")
+	}
+
+	for field, fieldType := range query.FieldTypes {
+		if !fieldTypes[fieldType] {
+			return nil, fmt.Errorf("query %v: unknown field type %v of the %v field", query.Name, fieldType, field)
+		}
+		types[field] = fieldType
+	}
+
+	return types, nil
+}
+
+// QueryFields returns the fields.yml definitions of the query fields with a
+// known type, they are added to the index template. The fields are under
+// mysql.<query name> with ecs enabled, at the root otherwise.
+func QueryFields(cfg *common.Config) ([]byte, error) {
+	c := config.DefaultConfig
+	if cfg.HasField("mysqlbeat") {
+		sub, err := cfg.Child("mysqlbeat", -1)
+		if err != nil {
+			return nil, err
+		}
+		if err := sub.Unpack(&c); err != nil {
+			return nil, fmt.Errorf("error reading config file: %v", err)
+		}
+	}
+
+	types := map[string]string{}
+	for _, query := range c.Queries {
+		queryTypes, err := queryFieldTypes(c, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for field, fieldType := range queryTypes {
+			if c.ECS {
+				field = "mysql." + queryDataset(query) + "." + field
+			}
+
+			// The queries sharing a field must agree on its type
+			if existing, exists := types[field]; exists && existing != fieldType {
+				return nil, fmt.Errorf("the %v field is both %v and %v", field, existing, fieldType)
+			}
+			types[field] = fieldType
+		}
+	}
+
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(types))
+	for field := range types {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("- key: mysqlbeat-queries\n")
+	buf.WriteString("  title: Queries\n")
+	buf.WriteString("  description: Fields of the configured queries.\n")
+	buf.WriteString("  fields:\n")
+	for _, field := range names {
+		fmt.Fprintf(&buf, "  - name: %q\n", field)
+		fmt.Fprintf(&buf, "    type: %v\n", types[field])
+	}
+
+	return buf.Bytes(), nil
+}
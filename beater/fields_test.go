@@ -0,0 +1,92 @@
+// +build !integration
+
+package beater
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestQueryFields(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"mysqlbeat": map[string]interface{}{
+			"queries": []map[string]interface{}{
+				{
+					"name":        "connections",
+					"type":        queryTypeMultipleRows,
+					"sql":         "SELECT id, host FROM information_schema.processlist",
+					"ip_columns":  []string{"host"},
+					"field_types": map[string]interface{}{"id": "long"},
+				},
+			},
+		},
+	})
+
+	fields, err := QueryFields(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{"- name: \"host\"\n    type: ip\n", "- name: \"id\"\n    type: long\n"} {
+		if !strings.Contains(string(fields), expected) {
+			t.Errorf("expected %q in\n%s", expected, fields)
+		}
+	}
+}
+
+func TestQueryFieldsUnknownType(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"mysqlbeat": map[string]interface{}{
+			"queries": []map[string]interface{}{
+				{"type": queryTypeSingleRow, "sql": "SELECT 1 AS one", "field_types": map[string]interface{}{"one": "number"}},
+			},
+		},
+	})
+
+	if _, err := QueryFields(cfg); err == nil {
+		t.Errorf("expected an unknown field type error")
+	}
+}
+
+func TestQueryFieldsColumnHints(t *testing.T) {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"mysqlbeat": map[string]interface{}{
+			"column_names": "snake_case",
+			"queries": []map[string]interface{}{
+				{
+					"name":             "tables",
+					"type":             queryTypeMultipleRows,
+					"sql":              "SELECT TableName, ReadOnly, Checksum, Size, Ratio, Uptime FROM t",
+					"string_columns":   []string{"TableName"},
+					"boolean_columns":  []string{"ReadOnly"},
+					"binary_columns":   map[string]interface{}{"Checksum": "hex"},
+					"decimal_columns":  map[string]interface{}{"Size": "scaled", "Ratio": "float"},
+					"duration_columns": []string{"Uptime"},
+					"field_types":      map[string]interface{}{"ratio": "scaled_float"},
+				},
+			},
+		},
+	})
+
+	fields, err := QueryFields(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for field, fieldType := range map[string]string{
+		"table_name": "keyword",
+		"read_only":  "boolean",
+		"checksum":   "keyword",
+		"size":       "long",
+		"size_scale": "long",
+		"ratio":      "scaled_float",
+		"uptime":     "double",
+	} {
+		expected := "- name: \"" + field + "\"\n    type: " + fieldType + "\n"
+		if !strings.Contains(string(fields), expected) {
+			t.Errorf("expected %q in\n%s", expected, fields)
+		}
+	}
+}
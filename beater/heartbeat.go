@@ -0,0 +1,45 @@
+package beater
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// heartbeatDataset is the event type and dataset of the heartbeat events
+const heartbeatDataset = "heartbeat"
+
+// cycleSummary is the collection of a host in a cycle
+type cycleSummary struct {
+	start   time.Time
+	queries int
+	failed  int
+	rows    int
+}
+
+// publishHeartbeat publishes the summary event of the collection of a host in
+// a cycle, a cheap liveness signal: the server is unreachable when the
+// collection failed with a connection error
+func (bt *Mysqlbeat) publishHeartbeat(h *host, summary cycleSummary, err error) {
+	duration := time.Since(summary.start)
+
+	event, _ := bt.generateEmptyEvent(h, heartbeatDataset, time.Now())
+	if bt.config.ECS {
+		h.applyECS(event, heartbeatDataset, true, duration)
+	} else {
+		setEventDataset(event, heartbeatDataset)
+	}
+	event.Fields["heartbeat"] = common.MapStr{
+		"queries":     summary.queries,
+		"failed":      summary.failed,
+		"rows":        summary.rows,
+		"duration_us": duration.Nanoseconds() / 1000,
+		"reachable":   err == nil || errorKind(err) != errorKindConnection,
+	}
+	event.Fields["collection"] = common.MapStr{
+		"cycle": bt.cycle,
+	}
+
+	bt.publish(h, []beat.Event{*event})
+}
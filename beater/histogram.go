@@ -0,0 +1,85 @@
+package beater
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// latencyBuckets are the upper bounds of the latency histogram buckets, the
+// last bucket counts the slower runs
+var latencyBuckets = []time.Duration{
+	1 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// latencyHistogram counts the runs of a query by duration bucket since the
+// beat started
+type latencyHistogram struct {
+	counts []int64
+	count  int64
+	sum    time.Duration
+	max    time.Duration
+}
+
+// observe counts a run
+func (l *latencyHistogram) observe(d time.Duration) {
+	if l.counts == nil {
+		l.counts = make([]int64, len(latencyBuckets)+1)
+	}
+
+	bucket := len(latencyBuckets)
+	for i, bound := range latencyBuckets {
+		if d <= bound {
+			bucket = i
+			break
+		}
+	}
+
+	l.counts[bucket]++
+	l.count++
+	l.sum += d
+	if d > l.max {
+		l.max = d
+	}
+}
+
+// mean returns the mean duration of the runs
+func (l *latencyHistogram) mean() time.Duration {
+	if l.count == 0 {
+		return 0
+	}
+
+	return l.sum / time.Duration(l.count)
+}
+
+// bucketName returns the name of a bucket: le_<bound> or gt_<last bound>
+func bucketName(i int) string {
+	if i < len(latencyBuckets) {
+		return "le_" + latencyBuckets[i].String()
+	}
+
+	return "gt_" + latencyBuckets[len(latencyBuckets)-1].String()
+}
+
+// String formats the histogram for the logs, the empty buckets are omitted
+func (l *latencyHistogram) String() string {
+	parts := []string{fmt.Sprintf("count=%d mean=%v max=%v", l.count, l.mean(), l.max)}
+	for i, n := range l.counts {
+		if n > 0 {
+			parts = append(parts, fmt.Sprintf("%s=%d", bucketName(i), n))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,27 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLatencyHistogram(t *testing.T) {
+	var l latencyHistogram
+	for _, d := range []time.Duration{500 * time.Microsecond, 3 * time.Millisecond, 4 * time.Millisecond, 30 * time.Second} {
+		l.observe(d)
+	}
+
+	if l.count != 4 || l.max != 30*time.Second {
+		t.Errorf("count %d, max %v", l.count, l.max)
+	}
+	if l.counts[0] != 1 || l.counts[1] != 2 || l.counts[len(latencyBuckets)] != 1 {
+		t.Errorf("buckets %v", l.counts)
+	}
+
+	expected := "count=4 mean=7.501875s max=30s le_1ms=1 le_5ms=2 gt_10s=1"
+	if l.String() != expected {
+		t.Errorf("histogram %q, expected %q", l.String(), expected)
+	}
+}
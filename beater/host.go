@@ -0,0 +1,685 @@
+package beater
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/anzot/mysqlbeat/config"
+	"github.com/anzot/mysqlbeat/module"
+)
+
+// host is a monitored MySQL server, its publisher client and the delta
+// calculation state of its queries.
+type host struct {
+	config  config.Host
+	network string
+	client  beat.Client
+	queries []config.Query
+	modules []*hostModule
+
+	// limiters are the rate limiters of the queries and breakers their
+	// circuit breakers, by query index
+	limiters []*rateLimiter
+	breakers []*circuitBreaker
+
+	// chains is the dependency chain of every query (see queryChains), the
+	// queries of a chain share a connection
+	chains []int
+
+	// stats is the last runs of the queries
+	stats hostStats
+	meta  *common.MapStrPointer
+
+	// location is the time zone of the server temporal values
+	location *time.Location
+
+	// pool is the connection pool settings
+	pool poolConfig
+
+	// identity is the instance name, server_uuid and version of the server,
+	// added to the events of the host
+	identity common.MapStr
+
+	// db is the connection pool of the host, heavyDB the connection of the
+	// heavy queries and statements the prepared queries of both, they are
+	// kept between the collection cycles
+	dbMutex    sync.Mutex
+	db         *sql.DB
+	heavyDB    *sql.DB
+	statements map[statementKey]*sql.Stmt
+
+	// heavyVariables are the session variables of the heavy queries connection
+	heavyVariables map[string]string
+
+	oldValues    common.MapStr
+	oldValuesAge common.MapStr
+}
+
+// statementKey identifies the prepared statement of a query on a connection pool
+type statementKey struct {
+	db    *sql.DB
+	query string
+}
+
+// poolConfig is the connection pool settings of a host
+type poolConfig struct {
+	maxOpenConns    int
+	maxIdleConns    int
+	connMaxLifetime time.Duration
+}
+
+// hostModule is a module instance of a host
+type hostModule struct {
+	module.Module
+	name      string
+	group     string
+	period    time.Duration
+	pipeline  string
+	lastFetch time.Time
+}
+
+const (
+	// defaultQueryGroup is the group of queries that don't declare one
+	defaultQueryGroup = "default"
+
+	// query run_on values
+	queryRunOnEveryHost  = ""
+	queryRunOnAnyReplica = "any_replica"
+)
+
+// newHosts builds the monitored hosts list. When no hosts are configured, the
+// global settings define a single host, unless the hosts are discovered.
+func newHosts(c config.Config) ([]*host, error) {
+	hostConfigs := c.Hosts
+	if len(hostConfigs) == 0 && c.SRV == nil && c.HostsFile == nil && c.Autodiscover == nil {
+		hostConfigs = []config.Host{{}}
+	}
+
+	var hosts []*host
+	for _, hc := range hostConfigs {
+		h, err := newHost(c, hc)
+		if err != nil {
+			return nil, err
+		}
+
+		hosts = append(hosts, h)
+	}
+
+	return hosts, nil
+}
+
+// newHost creates a monitored host, host settings that are left empty default
+// to the global settings.
+func newHost(c config.Config, hc config.Host) (*host, error) {
+	return buildHost(c, hc, queryRunOnEveryHost)
+}
+
+// newPoolHost creates a replica pool host, which only runs the queries that
+// can run on any replica.
+func newPoolHost(c config.Config, hc config.Host) (*host, error) {
+	h, err := buildHost(c, hc, queryRunOnAnyReplica)
+	if err != nil {
+		return nil, err
+	}
+
+	h.modules = nil
+	return h, nil
+}
+
+// buildHost creates a host that runs the queries with the given run_on value
+func buildHost(c config.Config, hc config.Host, runOn string) (*host, error) {
+	if hc.Hostname == "" {
+		hc.Hostname = c.Hostname
+	}
+	if hc.Port == "" {
+		hc.Port = c.Port
+	}
+	if hc.Username == "" {
+		hc.Username = c.Username
+	}
+	if hc.Password == "" && hc.EncryptedPassword == "" {
+		hc.Password = c.Password
+		hc.EncryptedPassword = c.EncryptedPassword
+	}
+	if hc.Timezone == "" {
+		hc.Timezone = c.Timezone
+	}
+	if hc.Charset == "" {
+		hc.Charset = c.Charset
+	}
+	if hc.Collation == "" {
+		hc.Collation = c.Collation
+	}
+	if hc.SSHTunnel == nil && hc.ProxyURL == "" {
+		hc.SSHTunnel = c.SSHTunnel
+		hc.ProxyURL = c.ProxyURL
+	}
+
+	if hc.Password == "" && hc.EncryptedPassword != "" {
+		password, err := decryptPassword(hc.EncryptedPassword)
+		if err != nil {
+			return nil, fmt.Errorf("host %v:%v: %v", hc.Hostname, hc.Port, err)
+		}
+		hc.Password = password
+	}
+
+	location, err := time.LoadLocation(hc.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("host %v:%v: invalid timezone: %v", hc.Hostname, hc.Port, err)
+	}
+
+	network := "tcp"
+	if hc.SSHTunnel != nil && hc.ProxyURL != "" {
+		return nil, fmt.Errorf("host %v:%v: ssh_tunnel and proxy_url cannot be used together", hc.Hostname, hc.Port)
+	} else if hc.SSHTunnel != nil {
+		network, err = registerSSHTunnel(*hc.SSHTunnel)
+		if err != nil {
+			return nil, fmt.Errorf("host %v:%v: %v", hc.Hostname, hc.Port, err)
+		}
+	} else if hc.ProxyURL != "" {
+		network, err = registerProxy(hc.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("host %v:%v: %v", hc.Hostname, hc.Port, err)
+		}
+	}
+
+	groups := c.QueryGroups
+	if len(hc.QueryGroups) > 0 {
+		groups = hc.QueryGroups
+	}
+
+	// Create the host own instances of the modules
+	var modules []*hostModule
+	for _, cfg := range c.Modules {
+		m, mc, err := module.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		modules = append(modules, &hostModule{
+			Module:   m,
+			name:     mc.Module,
+			group:    groupOrDefault(mc.Group),
+			period:   mc.Period,
+			pipeline: mc.Pipeline,
+		})
+	}
+
+	known := map[string]bool{}
+	for _, query := range c.Queries {
+		known[queryGroup(query)] = true
+	}
+	for _, m := range modules {
+		known[m.group] = true
+	}
+
+	enabled, err := selectGroups(known, groups, hc.IncludeGroups, hc.ExcludeGroups)
+	if err != nil {
+		return nil, fmt.Errorf("host %v:%v: %v", hc.Hostname, hc.Port, err)
+	}
+
+	queries, err := selectQueries(c.Queries, enabled, runOn)
+	if err != nil {
+		return nil, fmt.Errorf("host %v:%v: %v", hc.Hostname, hc.Port, err)
+	}
+
+	// Other instances collect the queries and modules of the other shards
+	shardKey := hostShardKey(hc, runOn)
+	queries = shardQueries(c.Shard, shardKey, queries)
+	modules = shardModules(c.Shard, shardKey, modules)
+
+	h := &host{
+		config:         hc,
+		network:        network,
+		location:       location,
+		heavyVariables: c.HeavySessionVariables,
+		pool: poolConfig{
+			maxOpenConns:    c.MaxOpenConns,
+			maxIdleConns:    c.MaxIdleConns,
+			connMaxLifetime: c.ConnMaxLifetime,
+		},
+		queries:      queries,
+		chains:       queryChains(queries),
+		stats:        hostStats{queries: make([]queryStats, len(queries))},
+		oldValues:    common.MapStr{},
+		oldValuesAge: common.MapStr{},
+	}
+
+	for _, query := range queries {
+		h.limiters = append(h.limiters, newRateLimiter(query.RateLimit))
+		h.breakers = append(h.breakers, &circuitBreaker{})
+	}
+
+	for _, m := range modules {
+		if enabled[m.group] {
+			h.modules = append(h.modules, m)
+		}
+	}
+
+	return h, nil
+}
+
+// selectGroups returns the enabled groups: the selected groups (all the known
+// groups when none is selected) plus the included groups, minus the excluded
+// groups.
+func selectGroups(known map[string]bool, groups, include, exclude []string) (map[string]bool, error) {
+	enabled := map[string]bool{}
+	for _, group := range append(append([]string{}, groups...), include...) {
+		if !known[group] {
+			return nil, fmt.Errorf("unknown query group: %v", group)
+		}
+		enabled[group] = true
+	}
+
+	// No selected group means all the groups
+	if len(groups) == 0 {
+		for group := range known {
+			enabled[group] = true
+		}
+	}
+
+	for _, group := range exclude {
+		if !known[group] {
+			return nil, fmt.Errorf("unknown query group: %v", group)
+		}
+		delete(enabled, group)
+	}
+
+	return enabled, nil
+}
+
+// selectQueries returns the queries with the given run_on value that belong to
+// the enabled groups, the queries keep their order.
+func selectQueries(queries []config.Query, enabled map[string]bool, runOn string) ([]config.Query, error) {
+	var selected []config.Query
+	names := map[string]bool{}
+	for _, query := range queries {
+		if enabled[queryGroup(query)] && query.RunOn == runOn {
+			selected = append(selected, query)
+			names[query.Name] = true
+		}
+	}
+
+	// A selected query cannot run without the queries it depends on
+	for _, query := range selected {
+		for _, dep := range query.DependsOn {
+			if !names[dep] {
+				return nil, fmt.Errorf("query %v depends on query %v which is not in the selected query groups or runs on other hosts", query.Name, dep)
+			}
+		}
+	}
+
+	return selected, nil
+}
+
+// queryGroup returns the group of the query
+func queryGroup(query config.Query) string {
+	return groupOrDefault(query.Group)
+}
+
+// groupOrDefault returns the group, or the default group when it's empty
+func groupOrDefault(group string) string {
+	if group == "" {
+		return defaultQueryGroup
+	}
+
+	return group
+}
+
+// connString builds the MySQL connection string of the host, the driver sets
+// the session variables on every new connection
+func (h *host) connString(sessionVariables map[string]string) string {
+	params := url.Values{}
+	for name, value := range sessionVariables {
+		params.Set(name, value)
+	}
+	if h.config.Charset != "" {
+		params.Set("charset", h.config.Charset)
+	}
+	if h.config.Collation != "" {
+		params.Set("collation", h.config.Collation)
+	}
+
+	connString := fmt.Sprintf("%v:%v@%v(%v:%v)/", h.config.Username, h.config.Password, h.network, h.config.Hostname, h.config.Port)
+	if len(params) > 0 {
+		connString += "?" + params.Encode()
+	}
+
+	return connString
+}
+
+// killQueryTimeout bounds the KILL QUERY statements of the timed out queries
+const killQueryTimeout = 5 * time.Second
+
+const (
+	// errUnsupportedPS is the error of the statements that can't be prepared
+	errUnsupportedPS = 1295
+
+	// errLockWaitTimeout and errDeadlock are the transient lock errors
+	errLockWaitTimeout = 1205
+	errDeadlock        = 1213
+
+	// errServerShutdown, errConnectionKilled and errUnknownStmtHandler are the
+	// errors of the connections and prepared statements of a server that is
+	// shutting down or restarted
+	errServerShutdown     = 1053
+	errUnknownStmtHandler = 1243
+	errConnectionKilled   = 1927
+)
+
+// open returns the connection pool of the host, it's opened by the first call
+// and after a close
+func (h *host) open() (*sql.DB, error) {
+	h.dbMutex.Lock()
+	defer h.dbMutex.Unlock()
+
+	if h.db == nil {
+		db, err := sql.Open("mysql", h.connString(nil))
+		if err != nil {
+			return nil, err
+		}
+
+		db.SetMaxOpenConns(h.pool.maxOpenConns)
+		db.SetMaxIdleConns(h.pool.maxIdleConns)
+		db.SetConnMaxLifetime(h.pool.connMaxLifetime)
+
+		h.db = db
+		h.statements = map[statementKey]*sql.Stmt{}
+	}
+
+	return h.db, nil
+}
+
+// openHeavy returns the connection of the heavy queries, which sets the
+// heavy_session_variables. The heavy queries run one at a time, apart from
+// the connections of the other queries.
+func (h *host) openHeavy() (*sql.DB, error) {
+	if _, err := h.open(); err != nil {
+		return nil, err
+	}
+
+	h.dbMutex.Lock()
+	defer h.dbMutex.Unlock()
+
+	if h.heavyDB == nil {
+		db, err := sql.Open("mysql", h.connString(h.heavyVariables))
+		if err != nil {
+			return nil, err
+		}
+
+		db.SetMaxOpenConns(1)
+		db.SetMaxIdleConns(1)
+		db.SetConnMaxLifetime(h.pool.connMaxLifetime)
+
+		h.heavyDB = db
+	}
+
+	return h.heavyDB, nil
+}
+
+// poolStats returns the statistics of the connection pool of the host, with
+// the connection of the heavy queries, they are empty when it isn't open
+func (h *host) poolStats() sql.DBStats {
+	h.dbMutex.Lock()
+	defer h.dbMutex.Unlock()
+
+	var stats sql.DBStats
+	if h.db != nil {
+		addDBStats(&stats, h.db.Stats())
+	}
+	if h.heavyDB != nil {
+		addDBStats(&stats, h.heavyDB.Stats())
+	}
+
+	return stats
+}
+
+// close closes the connection pool and the prepared statements of the host
+func (h *host) close() {
+	h.dbMutex.Lock()
+	defer h.dbMutex.Unlock()
+
+	if h.db == nil {
+		return
+	}
+
+	for _, stmt := range h.statements {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}
+	h.db.Close()
+	if h.heavyDB != nil {
+		h.heavyDB.Close()
+	}
+
+	h.db = nil
+	h.heavyDB = nil
+	h.statements = nil
+}
+
+// query runs a query, with its prepared statement when prepare is set. The
+// statements are prepared once and reused by the next cycles, the queries the
+// server can't prepare (some SHOW statements) run as plain queries.
+func (h *host) query(ctx context.Context, db *sql.DB, query string, prepare bool) (*sql.Rows, error) {
+	if !prepare {
+		return db.QueryContext(ctx, query)
+	}
+
+	stmt, err := h.prepare(ctx, db, query)
+	if err != nil {
+		return nil, err
+	}
+	if stmt == nil {
+		return db.QueryContext(ctx, query)
+	}
+
+	return stmt.QueryContext(ctx)
+}
+
+// queryConn returns a connection of a pool dedicated to a query, and the
+// server thread id of the connection
+func (h *host) queryConn(ctx context.Context, db *sql.DB) (*sql.Conn, int64, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var id int64
+	if err := conn.QueryRowContext(ctx, "SELECT CONNECTION_ID()").Scan(&id); err != nil {
+		conn.Close()
+		return nil, 0, err
+	}
+
+	return conn, id, nil
+}
+
+// chainConn is the connection of a dependency chain during a cycle, and its
+// server thread id
+type chainConn struct {
+	conn *sql.Conn
+	id   int64
+}
+
+// close returns the connection to its pool, a nil chainConn is a no-op
+func (c *chainConn) close() {
+	if c != nil {
+		c.conn.Close()
+	}
+}
+
+// heavyChain reports whether a query of a chain is heavy, the chain then runs
+// on the heavy queries connection
+func (h *host) heavyChain(chain int) bool {
+	for i, query := range h.queries {
+		if h.chains[i] == chain && query.Heavy {
+			return true
+		}
+	}
+
+	return false
+}
+
+// killQuery kills the running statement of a server thread, from a
+// connection of the main pool (the heavy queries pool has a single connection)
+func (h *host) killQuery(id int64) {
+	db, err := h.open()
+	if err != nil {
+		logp.Warn("Host %s: the timed out query of thread %d is not killed: %v", h, id, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), killQueryTimeout)
+	defer cancel()
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("KILL QUERY %d", id)); err != nil {
+		logp.Warn("Host %s: the timed out query of thread %d is not killed: %v", h, id, err)
+		return
+	}
+
+	logp.Debug("mysqlbeat", "Host %s: the timed out query of thread %d is killed", h, id)
+}
+
+// isConnError reports whether a query error is an error of the connection, or
+// the beat is stopping, rather than an error of the query itself (unknown
+// table, denied permission, invalid value...)
+func isConnError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+
+	return errorKind(err) == errorKindConnection
+}
+
+// isBrokenConn reports whether an error is caused by a broken or unreachable
+// connection, or by a server restart ("server has gone away", stale prepared
+// statements). The collection of the host stops and the next cycle reconnects.
+func isBrokenConn(err error) bool {
+	switch err {
+	case driver.ErrBadConn, mysql.ErrInvalidConn, io.EOF, io.ErrUnexpectedEOF:
+		return true
+	}
+	if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+		switch mysqlErr.Number {
+		case errServerShutdown, errUnknownStmtHandler, errConnectionKilled:
+			return true
+		}
+		return false
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+// isRetryable reports whether a query error is transient: a deadlock, a lock
+// wait timeout or a reset connection
+func isRetryable(err error) bool {
+	err = errorCause(err)
+	switch err {
+	case driver.ErrBadConn, mysql.ErrInvalidConn:
+		return true
+	}
+	if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+		return mysqlErr.Number == errDeadlock || mysqlErr.Number == errLockWaitTimeout
+	}
+
+	return false
+}
+
+// prepare returns the prepared statement of a query, nil when the query can't
+// be prepared. The statement is prepared without holding the lock, the
+// statement of a concurrent prepare of the same query is closed and the cached
+// one is returned.
+func (h *host) prepare(ctx context.Context, db *sql.DB, query string) (*sql.Stmt, error) {
+	key := statementKey{db: db, query: query}
+	h.dbMutex.Lock()
+	stmt, exists := h.statements[key]
+	h.dbMutex.Unlock()
+	if exists {
+		return stmt, nil
+	}
+
+	stmt, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); !ok || mysqlErr.Number != errUnsupportedPS {
+			return nil, err
+		}
+		stmt = nil
+	}
+
+	h.dbMutex.Lock()
+	defer h.dbMutex.Unlock()
+
+	if cached, exists := h.statements[key]; exists {
+		if stmt != nil {
+			stmt.Close()
+		}
+		return cached, nil
+	}
+
+	// The host was closed while preparing, the statement isn't kept and the
+	// query runs as a plain query of the closed pool
+	if h.statements == nil {
+		if stmt != nil {
+			stmt.Close()
+		}
+		return nil, nil
+	}
+	h.statements[key] = stmt
+
+	return stmt, nil
+}
+
+// loadIdentity collects the identity of the server. The MariaDB servers
+// don't have a server_uuid. The identity has only the instance name when the
+// server variables can't be read.
+func (h *host) loadIdentity(ctx context.Context, db *sql.DB) (common.MapStr, error) {
+	identity := common.MapStr{}
+	if h.config.Name != "" {
+		identity["name"] = h.config.Name
+	}
+
+	variables, err := module.QueryVariables(ctx, db, "SHOW GLOBAL VARIABLES WHERE Variable_name IN ('server_uuid', 'version')")
+	if err != nil {
+		return identity, err
+	}
+
+	for _, name := range []string{"server_uuid", "version"} {
+		if value, exists := variables[name]; exists {
+			identity[name] = value
+		}
+	}
+
+	return identity, nil
+}
+
+// String returns the host address, used to label logs and events
+func (h *host) String() string {
+	return fmt.Sprintf("%v:%v", h.config.Hostname, h.config.Port)
+}
+
+// newReplicaPool creates the hosts of the replica pool
+func newReplicaPool(c config.Config) ([]*host, error) {
+	var pool []*host
+	for _, hc := range c.ReplicaPool {
+		h, err := newPoolHost(c, hc)
+		if err != nil {
+			return nil, err
+		}
+
+		pool = append(pool, h)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,85 @@
+package beater
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+// The self-monitoring metrics of mysqlbeat, reported with the libbeat metrics
+// by the monitoring and the stats endpoint
+var (
+	metrics = monitoring.Default.NewRegistry("mysqlbeat")
+
+	metricQueriesExecuted = monitoring.NewInt(metrics, "queries.executed")
+	metricQueriesFailed   = monitoring.NewInt(metrics, "queries.failed")
+	metricQueriesSlow     = monitoring.NewInt(metrics, "queries.slow")
+	metricRowsFetched     = monitoring.NewInt(metrics, "rows.fetched")
+	metricEventsPublished = monitoring.NewInt(metrics, "events.published")
+	metricEventsPending   = monitoring.NewInt(metrics, "events.pending")
+	metricCycles          = monitoring.NewInt(metrics, "cycles.count")
+	metricCyclesSkipped   = monitoring.NewInt(metrics, "cycles.skipped")
+	metricCycleDuration   = monitoring.NewInt(metrics, "cycles.duration.ms")
+	metricDeltaKeys       = monitoring.NewInt(metrics, "delta.keys")
+
+	// connection pools of the hosts, the wait counts and the closed
+	// connections are those of the pools open since the last connection error
+	metricPoolOpen              = monitoring.NewInt(metrics, "pool.open")
+	metricPoolInUse             = monitoring.NewInt(metrics, "pool.in_use")
+	metricPoolIdle              = monitoring.NewInt(metrics, "pool.idle")
+	metricPoolWaitCount         = monitoring.NewInt(metrics, "pool.wait.count")
+	metricPoolWaitDuration      = monitoring.NewInt(metrics, "pool.wait.duration.ms")
+	metricPoolMaxIdleClosed     = monitoring.NewInt(metrics, "pool.closed.max_idle")
+	metricPoolMaxLifetimeClosed = monitoring.NewInt(metrics, "pool.closed.max_lifetime")
+
+	metricErrors = map[string]*monitoring.Int{
+		errorKindConnection: monitoring.NewInt(metrics, "errors.connection"),
+		errorKindQuery:      monitoring.NewInt(metrics, "errors.query"),
+		errorKindScan:       monitoring.NewInt(metrics, "errors.scan"),
+	}
+)
+
+// countError counts an error by its kind
+func countError(err error) {
+	metricErrors[errorKind(err)].Inc()
+}
+
+// countDeltaKeys sets the size of the delta calculation state of the hosts
+func countDeltaKeys(hosts []*host) {
+	keys := 0
+	for _, h := range hosts {
+		keys += len(h.oldValues)
+	}
+
+	metricDeltaKeys.Set(int64(keys))
+}
+
+// countPoolStats sets the connection pool metrics, the totals of the pools of
+// the hosts
+func countPoolStats(hosts []*host) {
+	var total sql.DBStats
+	for _, h := range hosts {
+		addDBStats(&total, h.poolStats())
+	}
+
+	metricPoolOpen.Set(int64(total.OpenConnections))
+	metricPoolInUse.Set(int64(total.InUse))
+	metricPoolIdle.Set(int64(total.Idle))
+	metricPoolWaitCount.Set(total.WaitCount)
+	metricPoolWaitDuration.Set(total.WaitDuration.Nanoseconds() / int64(time.Millisecond))
+	metricPoolMaxIdleClosed.Set(total.MaxIdleClosed)
+	metricPoolMaxLifetimeClosed.Set(total.MaxLifetimeClosed)
+}
+
+// addDBStats adds the statistics of a connection pool to a total
+func addDBStats(total *sql.DBStats, stats sql.DBStats) {
+	total.MaxOpenConnections += stats.MaxOpenConnections
+	total.OpenConnections += stats.OpenConnections
+	total.InUse += stats.InUse
+	total.Idle += stats.Idle
+	total.WaitCount += stats.WaitCount
+	total.WaitDuration += stats.WaitDuration
+	total.MaxIdleClosed += stats.MaxIdleClosed
+	total.MaxLifetimeClosed += stats.MaxLifetimeClosed
+}
@@ -1,30 +1,79 @@
 package beater
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"io"
 	"math"
-	"strconv"
+	"math/rand"
+	"net/http"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/elastic/beats/libbeat/autodiscover"
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/libbeat/logp"
 
 	_ "github.com/go-sql-driver/mysql"
 
 	"github.com/anzot/mysqlbeat/config"
+	"github.com/anzot/mysqlbeat/module"
 )
 
 // Mysqlbeat configuration.
 type Mysqlbeat struct {
-	done   chan struct{}
-	config config.Config
-	client beat.Client
+	// pending is the number of published events not yet acknowledged by the
+	// output (first field, 64-bit aligned for the atomic operations)
+	pending int64
 
-	oldValues    common.MapStr
-	oldValuesAge common.MapStr
+	done     chan struct{}
+	config   config.Config
+	pipeline beat.Pipeline
+
+	// dryRun is the file of the dry run events, when it isn't stdout
+	dryRun io.Closer
+
+	autodiscover *autodiscover.Autodiscover
+
+	// running is done when Run returns, Stop waits for the current cycle
+	// before closing the hosts
+	running sync.WaitGroup
+
+	hostsMutex sync.Mutex
+	hosts      []*host
+	discovered map[string]map[string]*host
+
+	// removed are the discovered hosts removed during the running cycle,
+	// closed before the next one
+	removed []*host
+
+	// Hosts running the any_replica queries in turn, and the next one to use
+	pool     []*host
+	poolNext int
+
+	// processors are the metadata processors of the hosts clients
+	processors beat.ProcessorList
+
+	// limiter is the global rate limiter of the query events
+	limiter *rateLimiter
+
+	// cycle is the number of the current collection cycle
+	cycle uint64
+
+	// diagnostics is the pprof and expvar HTTP endpoint and prometheus the
+	// Prometheus metrics endpoint, when enabled
+	diagnostics *http.Server
+	prometheus  *http.Server
+
+	// latenciesLogged is when the latency histograms were last logged
+	latenciesLogged time.Time
+
+	// tracer exports the traces of the cycles, when tracing is enabled
+	tracer *tracer
 }
 
 const (
@@ -34,13 +83,24 @@ const (
 	queryTypeTwoColumns   = "two-columns"
 	queryTypeSlaveDelay   = "show-slave-delay"
 
+	// query sample_mode values
+	sampleModeNth    = "nth"
+	sampleModeRandom = "random"
+
 	// special column names values
 	columnNameSlaveDelay = "Seconds_Behind_Master"
 
+	// deltaSelector is the debug selector of the delta computations
+	deltaSelector = "delta"
+
 	// column types values
 	columnTypeString = iota
 	columnTypeInt
 	columnTypeFloat
+	columnTypeOther
+	columnTypeDecimal
+	columnTypeBinary
+	columnTypeSkip
 )
 
 // New creates an instance of mysqlbeat.
@@ -50,8 +110,12 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 		return nil, fmt.Errorf("error reading config file: %v", err)
 	}
 
-	if len(c.Queries) < 1 {
-		return nil, fmt.Errorf("there are no queries to execute")
+	if len(c.Queries) < 1 && len(c.Modules) < 1 {
+		return nil, fmt.Errorf("there are no queries or modules to execute")
+	}
+
+	if err := checkShard(c.Shard); err != nil {
+		return nil, err
 	}
 
 	safeQueries := true
@@ -77,7 +141,40 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 			return nil, err
 		}
 
-		logp.Info("Query #%d (type: %s): %s", i, query.Type, query.SQL)
+		if query.Type == queryTypeSlaveDelay {
+			cfgwarn.Deprecate("", "The %s query type is deprecated, use the replication module instead.", queryTypeSlaveDelay)
+		}
+
+		switch query.RunOn {
+		case queryRunOnEveryHost:
+		case queryRunOnAnyReplica:
+			if len(c.ReplicaPool) == 0 {
+				return nil, fmt.Errorf("query #%d runs on any replica but the replica_pool is empty", i)
+			}
+		default:
+			return nil, fmt.Errorf("unknown query run_on: %v", query.RunOn)
+		}
+
+		if _, err := newColumnOptions(c, query); err != nil {
+			return nil, fmt.Errorf("query #%d: %v", i, err)
+		}
+		if _, err := queryFieldTypes(c, query); err != nil {
+			return nil, err
+		}
+
+		switch query.SampleMode {
+		case "", sampleModeNth, sampleModeRandom:
+		default:
+			return nil, fmt.Errorf("query #%d: unknown sample_mode: %v", i, query.SampleMode)
+		}
+
+		switch queryRateLimitPolicy(c, query) {
+		case rateLimitDrop, rateLimitDelay:
+		default:
+			return nil, fmt.Errorf("query #%d: unknown rate_limit_policy: %v", i, queryRateLimitPolicy(c, query))
+		}
+
+		logp.Info("Query #%d (type: %s, group: %s): %s", i, query.Type, queryGroup(query), query.SQL)
 		i++
 	}
 
@@ -86,23 +183,167 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 		return nil, err
 	}
 
+	// The dedicated connections of kill_timed_out_queries are closed after
+	// their query, a statement prepared on them couldn't be reused
+	if c.PrepareQueries && c.KillTimedOutQueries {
+		logp.Warn("prepare_queries and kill_timed_out_queries are both set: the queries with a timeout aren't prepared")
+	}
+
+	logp.Info("Total # of modules to run: %d", len(c.Modules))
+
+	for i, cfg := range c.Modules {
+		_, mc, err := module.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		logp.Info("Module #%d (group: %s): %s", i, groupOrDefault(mc.Group), mc.Module)
+	}
+
+	// Make sure every query runs after the queries it depends on
+	queries, err := orderQueries(c.Queries)
+	if err != nil {
+		return nil, err
+	}
+	c.Queries = queries
+
+	hosts, err := newHosts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	logp.Info("Total # of hosts to monitor: %d", len(hosts))
+
+	for i, h := range hosts {
+		logp.Info("Host #%d: %s (%d queries)", i, h, len(h.queries))
+	}
+
+	pool, err := newReplicaPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pool) > 0 {
+		logp.Info("Total # of replica pool hosts: %d", len(pool))
+	}
+
+	if c.SRV != nil {
+		logp.Info("Hosts are discovered from the SRV record: %s", c.SRV.Name)
+	}
+
+	if c.HostsFile != nil {
+		logp.Info("Hosts are loaded from the hosts file: %s", c.HostsFile.Path)
+	}
+
+	metadataProcessors, err := newMetadataProcessors(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// The events of a dry run are written as NDJSON instead of published
+	pipeline := b.Publisher
+	var dryRun io.Closer
+	if DryRun != "" {
+		pipeline, dryRun, err = newDryRunPipeline(DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("error opening the dry run file: %v", err)
+		}
+		logp.Info("Dry run: the events are written to %s instead of the output", DryRun)
+	}
+
 	bt := &Mysqlbeat{
-		done:         make(chan struct{}),
-		config:       c,
-		oldValues:    common.MapStr{},
-		oldValuesAge: common.MapStr{},
+		done:       make(chan struct{}),
+		config:     c,
+		pipeline:   pipeline,
+		dryRun:     dryRun,
+		hosts:      hosts,
+		pool:       pool,
+		discovered: map[string]map[string]*host{},
+		processors: metadataProcessors,
+		limiter:    newRateLimiter(c.RateLimit),
+	}
+	if c.Tracing != nil && c.Tracing.Enabled {
+		bt.tracer = newTracer(*c.Tracing)
+	}
+
+	if c.Autodiscover != nil {
+		adapter := autodiscover.NewFactoryAdapter(&hostFactory{bt: bt})
+		bt.autodiscover, err = autodiscover.NewAutodiscover("mysqlbeat", bt.pipeline, adapter, c.Autodiscover)
+		if err != nil {
+			return nil, err
+		}
 	}
+
 	return bt, nil
 }
 
 // Run starts mysqlbeat.
 func (bt *Mysqlbeat) Run(b *beat.Beat) error {
 	logp.Info("mysqlbeat is running! Hit CTRL-C to stop it.")
+	bt.running.Add(1)
+	defer bt.running.Done()
 
-	var err error
-	bt.client, err = b.Publisher.Connect()
-	if err != nil {
-		return err
+	bt.registerQueryStats()
+	bt.registerPoolStats()
+
+	if bt.config.Diagnostics != nil && bt.config.Diagnostics.Enabled {
+		var err error
+		bt.diagnostics, err = startDiagnostics(*bt.config.Diagnostics)
+		if err != nil {
+			return fmt.Errorf("error starting the diagnostics endpoint: %v", err)
+		}
+	}
+
+	if bt.config.Prometheus != nil && bt.config.Prometheus.Enabled {
+		var err error
+		bt.prometheus, err = startPrometheus(*bt.config.Prometheus)
+		if err != nil {
+			return fmt.Errorf("error starting the Prometheus endpoint: %v", err)
+		}
+	}
+
+	// Every host publishes through its own client
+	for _, h := range append(append([]*host{}, bt.hosts...), bt.pool...) {
+		var err error
+		h.client, err = bt.connect(bt.pipeline)
+		if err != nil {
+			return err
+		}
+	}
+
+	if bt.config.SRV != nil {
+		go bt.runSRVDiscovery()
+	}
+
+	if bt.config.HostsFile != nil {
+		go bt.runHostsFileReload()
+	}
+
+	for _, h := range bt.hosts {
+		if h.config.DiscoverReplicas {
+			go bt.runReplicaDiscovery(h)
+		}
+	}
+
+	if bt.autodiscover != nil {
+		bt.autodiscover.Start()
+	}
+
+	// The context cancels the running queries when the beat is stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-bt.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if bt.config.VerifyQueries.Enabled {
+		if err := bt.verifyQueries(ctx); err != nil {
+			return err
+		}
 	}
 
 	ticker := time.NewTicker(bt.config.Period)
@@ -113,178 +354,697 @@ func (bt *Mysqlbeat) Run(b *beat.Beat) error {
 		case <-ticker.C:
 		}
 
-		err := bt.beat(b)
+		err := bt.beat(ctx, b)
 		if err != nil {
 			return err
 		}
 	}
 }
 
-// Stop stops mysqlbeat.
+// Stop stops mysqlbeat. The running queries are canceled, the clients and the
+// connections are closed once the current cycle returns.
 func (bt *Mysqlbeat) Stop() {
-	bt.client.Close()
 	close(bt.done)
+	bt.running.Wait()
+
+	if bt.autodiscover != nil {
+		bt.autodiscover.Stop()
+	}
+
+	if bt.diagnostics != nil {
+		bt.diagnostics.Close()
+	}
+	if bt.prometheus != nil {
+		bt.prometheus.Close()
+	}
+
+	bt.closeRemovedHosts()
+	for _, h := range append(bt.activeHosts(), bt.pool...) {
+		if h.client != nil {
+			h.client.Close()
+		}
+		h.close()
+	}
+	if bt.dryRun != nil {
+		bt.dryRun.Close()
+	}
 }
 
-func (bt *Mysqlbeat) beat(b *beat.Beat) error {
-	// Build the MySQL connection string
-	connString := fmt.Sprintf("%v:%v@tcp(%v:%v)/", bt.config.Username, bt.config.Password, bt.config.Hostname, bt.config.Port)
+func (bt *Mysqlbeat) beat(ctx context.Context, b *beat.Beat) error {
+	// The hosts removed during the previous cycle aren't collected anymore
+	bt.closeRemovedHosts()
+
+	// The cycle is skipped while the output is congested, it would only pile
+	// more events onto it
+	if pending, congested := bt.backpressured(); congested {
+		logp.Warn("Skipping the collection cycle: %d events are waiting for the output", pending)
+		metricCyclesSkipped.Inc()
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	bt.cycle++
+	metricCycles.Inc()
+	start := time.Now()
+	ctx, root := bt.tracer.startCycle(ctx, bt.cycle)
 
-	db, err := sql.Open("mysql", connString)
+	// Collect all hosts concurrently, a failing host doesn't affect the others
+	for _, h := range bt.activeHosts() {
+		wg.Add(1)
+		go func(h *host) {
+			defer wg.Done()
+
+			if err := bt.collect(ctx, h); err != nil {
+				logp.Err("Host %s: %v", h, err)
+			}
+		}(h)
+	}
+
+	if len(bt.pool) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bt.collectPool(ctx)
+		}()
+	}
+
+	wg.Wait()
+
+	root.finish(nil)
+	bt.tracer.export(root)
+
+	metricCycleDuration.Set(time.Since(start).Nanoseconds() / int64(time.Millisecond))
+	countDeltaKeys(append(bt.activeHosts(), bt.pool...))
+	countPoolStats(append(bt.activeHosts(), bt.pool...))
+
+	// The latency histograms are logged every latency_log_period in the
+	// debug output
+	if bt.config.LatencyLogPeriod > 0 && logp.IsDebug("mysqlbeat") && time.Since(bt.latenciesLogged) >= bt.config.LatencyLogPeriod {
+		bt.latenciesLogged = time.Now()
+		for _, h := range append(bt.activeHosts(), bt.pool...) {
+			h.logLatencies()
+		}
+	}
+
+	return nil
+}
+
+// collectPool runs the any_replica queries on the next replica pool host,
+// falling back to the following hosts when it fails
+func (bt *Mysqlbeat) collectPool(ctx context.Context) {
+	for attempt := 0; attempt < len(bt.pool); attempt++ {
+		h := bt.pool[bt.poolNext]
+		bt.poolNext = (bt.poolNext + 1) % len(bt.pool)
+
+		err := bt.collect(ctx, h)
+		if err == nil {
+			return
+		}
+
+		logp.Err("Replica pool host %s: %v", h, err)
+	}
+}
+
+// collect runs all the queries against a single host and publishes the results
+func (bt *Mysqlbeat) collect(ctx context.Context, h *host) (err error) {
+	// The host may be collected by the other shards only
+	if len(h.queries) == 0 && len(h.modules) == 0 {
+		return nil
+	}
+
+	summary := cycleSummary{start: time.Now()}
+	defer func() {
+		if bt.config.HeartbeatEvents && ctx.Err() == nil {
+			bt.publishHeartbeat(h, summary, err)
+		}
+	}()
+
+	ctx, hostSpan := startSpan(ctx, "collect "+h.String(), spanKindInternal)
+	hostSpan.setAttribute("db.system", "mysql")
+	hostSpan.setAttribute("net.peer.name", h.config.Hostname)
+	hostSpan.setAttribute("net.peer.port", h.config.Port)
+	defer func() {
+		hostSpan.finish(err)
+	}()
+
+	db, err := h.open()
 	if err != nil {
-		return err
+		return newCollectError(errorKindConnection, err)
 	}
-	defer db.Close()
 
-	for i, query := range bt.config.Queries {
-		events, err := bt.iterateQuery(db, i, query.Type, query.SQL)
+	// The connections, the prepared statements and the identity are kept
+	// between the cycles, the server may have been restarted or upgraded when
+	// an error occurred
+	defer func() {
+		if err != nil {
+			if errorKind(err) == errorKindConnection && ctx.Err() == nil {
+				countError(err)
+				logp.Info("Host %s: connections and prepared statements reset, reconnecting on the next cycle", h)
+				bt.publishError(h, errorSourceHost, errorKindConnection, errorKindConnection, true, h.String(), err, 0)
+			}
+			h.identity = nil
+			h.close()
+		}
+	}()
+	// The identity is best-effort: the servers refusing the statement (the
+	// ProxySQL admin interface, the accounts without the privilege) are still
+	// collected, with the instance name only until the next connection
+	if h.identity == nil {
+		var identityErr error
+		h.identity, identityErr = h.loadIdentity(ctx, db)
+		if identityErr != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logp.Warn("Host %s: server identity lookup failed: %v", h, identityErr)
+		}
+	}
+
+	// The queries of a dependency chain run on one connection, which keeps
+	// the session state (session variables, temporary tables) between them.
+	// It's released after the last query of the chain.
+	var chain *chainConn
+	chainIndex := -1
+	defer func() {
+		chain.close()
+	}()
+
+	// A failing query is skipped with the queries depending on it, the other
+	// queries still run. The connection errors stop the collection of the host.
+	failed := map[string]bool{}
+	for i, query := range h.queries {
+		if h.chains[i] != chainIndex {
+			chain.close()
+			chain, chainIndex = nil, h.chains[i]
+		}
+
+		if dep := failedDependency(query, failed); dep != "" {
+			logp.Err("Host %s query %v skipped: query %v failed", h, queryLabel(i, query), dep)
+			failed[query.Name] = true
+			continue
+		}
+
+		// The queries disabled by their circuit breaker are skipped too
+		breaker := h.breakers[i]
+		if !breaker.allow(time.Now()) {
+			logp.Debug("mysqlbeat", "Host %s query %v skipped: circuit breaker open", h, queryLabel(i, query))
+			failed[query.Name] = true
+			continue
+		}
+
+		opts, err := newColumnOptions(bt.config, query)
 		if err != nil {
 			return err
 		}
+		opts.location = h.location
+
+		start := time.Now()
+		limiter := h.limiters[i]
+		rows := 0
+		publish := func(events []*beat.Event, progress queryProgress) {
+			rows = progress.rows
+			bt.publishQueryEvents(ctx, h, query, opts, limiter, events, progress, time.Since(start))
+		}
 
-		for _, event := range events {
-			bt.client.Publish(*event)
+		queryDB := db
+		if query.Heavy || (chainIndex >= 0 && h.heavyChain(chainIndex)) {
+			queryDB, err = h.openHeavy()
+			if err != nil {
+				return newCollectError(errorKindConnection, err)
+			}
+		}
+		if chainIndex >= 0 && chain == nil {
+			conn, id, err := h.queryConn(ctx, queryDB)
+			if err != nil {
+				return newCollectError(errorKindConnection, err)
+			}
+			chain = &chainConn{conn: conn, id: id}
+		}
+
+		metricQueriesExecuted.Inc()
+		summary.queries++
+		queryCtx, querySpan := startSpan(ctx, "query "+queryLabel(i, query), spanKindClient)
+		querySpan.setAttribute("db.system", "mysql")
+		querySpan.setAttribute("db.statement", query.SQL)
+		err = bt.runQuery(queryCtx, h, queryDB, chain, i, query, opts, publish)
+		querySpan.setAttribute("db.rows", rows)
+		querySpan.finish(err)
+		h.stats.record(i, start, rows, err)
+		summary.rows += rows
+		bt.checkSlowQuery(h, i, query, time.Since(start))
+		if err != nil {
+			metricQueriesFailed.Inc()
+			summary.failed++
+			if isConnError(ctx, err) {
+				return err
+			}
+			countError(err)
+			logp.Err("Host %s query %v: %v", h, queryLabel(i, query), err)
+			failed[query.Name] = true
+			bt.publishError(h, errorSourceQuery, query.Type, queryDataset(query), false, query.Name, err, time.Since(start))
+			if breaker.failure(time.Now(), bt.config.CircuitBreaker) {
+				bt.publishBreakerState(h, query, breaker, breakerStateOpen)
+			}
+		} else if breaker.success() {
+			bt.publishBreakerState(h, query, breaker, breakerStateClosed)
 		}
 
 		i++
 	}
 
+	for _, m := range h.modules {
+		// Modules with a period longer than the beat period skip some cycles
+		if m.period > 0 && time.Since(m.lastFetch) < m.period {
+			continue
+		}
+		m.lastFetch = time.Now()
+
+		moduleCtx, moduleSpan := startSpan(ctx, "module "+m.name, spanKindClient)
+		events, err := bt.fetchModule(moduleCtx, h, db, m)
+		moduleSpan.finish(err)
+		if err != nil {
+			err = newCollectError(errorKindQuery, err)
+			if isConnError(ctx, err) {
+				return err
+			}
+			countError(err)
+			logp.Err("Host %s module %s: %v", h, m.name, err)
+			bt.publishError(h, errorSourceModule, m.name, m.name, true, m.name, err, time.Since(m.lastFetch))
+			continue
+		}
+		duration := time.Since(m.lastFetch)
+
+		batch := make([]beat.Event, 0, len(events))
+		for _, event := range events {
+			if bt.config.ECS {
+				h.applyECS(event, m.name, true, duration)
+			} else {
+				setEventDataset(event, m.name)
+			}
+			setPipeline(event, m.pipeline)
+			batch = append(batch, *event)
+		}
+		bt.publish(h, batch)
+	}
+
 	return nil
 }
 
-func (bt *Mysqlbeat) iterateQuery(db *sql.DB, i int, queryType string, queryStr string) ([]*beat.Event, error) {
-	// Log the query run time and run the query
+// queryProgress is the progress of a query when a batch of its events is
+// published: the rows read so far, the rows skipped because they couldn't be
+// scanned or converted, and whether the query failed before its last row
+type queryProgress struct {
+	rows        int
+	skippedRows int
+	partial     bool
+}
+
+// publishQueryEvents completes and publishes a batch of query events with a
+// single PublishAll call, the collection duration and rows are those of the
+// query when the batch is published. The events over the rate limits are
+// dropped or delayed.
+func (bt *Mysqlbeat) publishQueryEvents(ctx context.Context, h *host, query config.Query, opts columnOptions, limiter *rateLimiter, events []*beat.Event, progress queryProgress, duration time.Duration) {
+	if allowed := limitEvents(ctx, len(events), queryRateLimitPolicy(bt.config, query), bt.limiter, limiter); allowed < len(events) {
+		logp.Debug("mysqlbeat", "Host %s query %v: %d events dropped by the rate limit", h, query.Name, len(events)-allowed)
+		events = events[:allowed]
+	}
+
+	batch := make([]beat.Event, 0, len(events))
+	for _, event := range events {
+		replaceNonFinite(event.Fields, opts.nonFinite)
+		if bt.config.ExpandDots {
+			expandDots(event.Fields)
+		}
+		if bt.config.ECS {
+			h.applyECS(event, queryDataset(query), false, duration)
+		} else {
+			setEventDataset(event, queryDataset(query))
+		}
+		setPipeline(event, query.Pipeline)
+		if event.Fields != nil {
+			collection := common.MapStr{
+				"query":       query.Name,
+				"duration_us": duration.Nanoseconds() / 1000,
+				"rows":        progress.rows,
+				"cycle":       bt.cycle,
+			}
+			if progress.skippedRows > 0 {
+				collection["skipped_rows"] = progress.skippedRows
+			}
+			if progress.partial {
+				collection["partial"] = true
+			}
+			if query.SampleRate > 1 && query.Type == queryTypeMultipleRows {
+				collection["sample_rate"] = query.SampleRate
+			}
+			event.Fields["collection"] = collection
+		}
+		batch = append(batch, *event)
+	}
+
+	bt.publish(h, batch)
+}
+
+// checkSlowQuery warns about a query whose run took more than the
+// slow_query_threshold percentage of the period
+func (bt *Mysqlbeat) checkSlowQuery(h *host, i int, query config.Query, duration time.Duration) {
+	if bt.config.SlowQueryThreshold <= 0 || bt.config.Period <= 0 {
+		return
+	}
+
+	percent := 100 * float64(duration) / float64(bt.config.Period)
+	if percent < bt.config.SlowQueryThreshold {
+		return
+	}
+
+	metricQueriesSlow.Inc()
+	logp.Warn("Host %s query %v is slow: it took %v, %.0f%% of the %v period", h, queryLabel(i, query), duration, percent, bt.config.Period)
+}
+
+// failedDependency returns the name of a query the query depends on that
+// failed in the cycle, or an empty string
+func failedDependency(query config.Query, failed map[string]bool) string {
+	for _, dep := range query.DependsOn {
+		if failed[dep] {
+			return dep
+		}
+	}
+
+	return ""
+}
+
+// sampleRow reports whether the row of a multiple-rows query is published: the
+// queries with a sample_rate of N publish every Nth row, or each row with a
+// probability of 1/N in the random sample_mode
+func sampleRow(query config.Query, rowCount int) bool {
+	if query.SampleRate <= 1 {
+		return true
+	}
+	if query.SampleMode == sampleModeRandom {
+		return rand.Intn(query.SampleRate) == 0
+	}
+
+	return (rowCount-1)%query.SampleRate == 0
+}
+
+// queryRateLimitPolicy returns the rate_limit_policy of a query
+func queryRateLimitPolicy(c config.Config, query config.Query) string {
+	if query.RateLimitPolicy != "" {
+		return query.RateLimitPolicy
+	}
+
+	return c.RateLimitPolicy
+}
+
+// fetchModule runs a module and generates its events
+func (bt *Mysqlbeat) fetchModule(ctx context.Context, h *host, db *sql.DB, m *hostModule) ([]*beat.Event, error) {
 	dtNow := time.Now()
-	rows, err := db.Query(queryStr)
+	results, err := m.Fetch(ctx, db)
 	if err != nil {
-		logp.L().Error("Query #%v error generating event from rows: %v", i, err)
 		return nil, err
 	}
+
+	var events []*beat.Event
+	for _, fields := range results {
+		event, err := bt.generateEmptyEvent(h, m.name, dtNow)
+		if err != nil {
+			return events, err
+		}
+
+		event.Fields.DeepUpdate(fields)
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// runQuery runs a query, retrying it up to query_retries times after the
+// transient errors, with a backoff growing with the attempts. A query isn't
+// retried once some of its events are published, they would be duplicated.
+func (bt *Mysqlbeat) runQuery(ctx context.Context, h *host, db *sql.DB, chain *chainConn, i int, query config.Query, opts columnOptions, publish func([]*beat.Event, queryProgress)) error {
+	for attempt := 1; ; attempt++ {
+		published := false
+		err := bt.iterateQuery(ctx, h, db, chain, i, query, opts, func(events []*beat.Event, progress queryProgress) {
+			published = true
+			publish(events, progress)
+		})
+		if err == nil || published || attempt > bt.config.QueryRetries || !isRetryable(err) {
+			return err
+		}
+
+		logp.Warn("Host %s query %v failed, retrying (attempt %d of %d): %v", h, queryLabel(i, query), attempt, bt.config.QueryRetries, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt) * bt.config.QueryRetryBackoff):
+		}
+	}
+}
+
+// iterateQuery runs a query and hands its events to publish with the progress
+// of the query. The events of the multiple-rows queries are published by
+// batches of publish_batch_size rows while the rows are read, so that large
+// results aren't held in memory, and the rows of a multiple-rows query that
+// can't be scanned or converted are skipped. The events that aren't published
+// yet are dropped when an error occurs. The queries of a dependency chain run
+// on the connection of the chain.
+func (bt *Mysqlbeat) iterateQuery(ctx context.Context, h *host, db *sql.DB, chain *chainConn, i int, query config.Query, opts columnOptions, publish func([]*beat.Event, queryProgress)) error {
+	queryType := query.Type
+
+	// The query is canceled when the beat stops or after its timeout
+	timeout := bt.config.QueryTimeout
+	if query.Timeout > 0 {
+		timeout = query.Timeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// Log the query run time and run the query
+	dtNow := time.Now()
+	var rows *sql.Rows
+	var err error
+	if chain != nil {
+		// The statements prepared on the connection of a chain would only
+		// last for the cycle, the queries of a chain aren't prepared
+		if timeout > 0 && bt.config.KillTimedOutQueries {
+			defer func() {
+				if ctx.Err() != nil {
+					h.killQuery(chain.id)
+				}
+			}()
+		}
+
+		rows, err = chain.conn.QueryContext(ctx, query.SQL)
+		if err != nil {
+			return newCollectError(errorKindQuery, err)
+		}
+	} else if timeout > 0 && bt.config.KillTimedOutQueries {
+		// The query runs on a dedicated connection, whose thread is
+		// killed on the server when the query is canceled. The connection
+		// is closed after the query, so the query isn't prepared.
+		conn, id, err := h.queryConn(ctx, db)
+		if err != nil {
+			return newCollectError(errorKindConnection, err)
+		}
+		defer conn.Close()
+		defer func() {
+			if ctx.Err() != nil {
+				h.killQuery(id)
+			}
+		}()
+
+		rows, err = conn.QueryContext(ctx, query.SQL)
+		if err != nil {
+			return newCollectError(errorKindQuery, err)
+		}
+	} else {
+		rows, err = h.query(ctx, db, query.SQL, bt.config.PrepareQueries)
+		if err != nil {
+			return newCollectError(errorKindQuery, err)
+		}
+	}
 	defer rows.Close()
 
 	// Populate columns array
 	columns, err := rows.Columns()
 	if err != nil {
-		return nil, err
+		return newCollectError(errorKindQuery, err)
+	}
+	dbTypes := columnDatabaseTypes(rows, columns)
+	if logp.IsDebug("mysqlbeat") {
+		logp.Debug("mysqlbeat", "Host %s query #%v columns: %v", h, i, describeColumns(columns, dbTypes))
 	}
 
 	var events []*beat.Event
+	rowCount := 0
+
+	// The scan destinations are reused by the rows
+	buffer := getRowBuffer(len(columns))
+	defer putRowBuffer(buffer)
 
 	switch queryType {
 	case queryTypeSingleRow, queryTypeSlaveDelay:
-		rows.Next()
-		event, err := bt.generateEventFromRow(rows, columns, queryType, dtNow)
+		if rows.Next() {
+			rowCount++
+			metricRowsFetched.Inc()
+		}
+		values, err := buffer.scan(rows)
+		if err != nil {
+			return newCollectError(errorKindScan, err)
+		}
+		event, err := bt.generateEventFromRow(h, values, columns, dbTypes, queryType, opts, dtNow)
+		if err != nil {
+			return newCollectError(errorKindScan, err)
+		}
 		if event != nil {
-			events = append(events, event)
+			publish([]*beat.Event{event}, queryProgress{rows: rowCount})
 		}
 
-		return events, err
+		return nil
 
 	case queryTypeMultipleRows:
-		for rows.Next() {
-			event, err := bt.generateEventFromRow(rows, columns, queryType, dtNow)
+		// Bytes of the rows of the events that aren't published yet
+		batchBytes := 0
+		skippedRows := 0
 
+		for rows.Next() {
+			rowCount++
+			metricRowsFetched.Inc()
+			if !sampleRow(query, rowCount) {
+				continue
+			}
+			values, err := buffer.scan(rows)
+			if err == nil {
+				var event *beat.Event
+				event, err = bt.generateEventFromRow(h, values, columns, dbTypes, queryType, opts, dtNow)
+				if err == nil && event != nil {
+					events = append(events, event)
+					batchBytes += buffer.size()
+				}
+			}
 			if err != nil {
-				return events, err
-			} else if event != nil {
-				events = append(events, event)
+				err = newCollectError(errorKindScan, err)
+				if errorKind(err) == errorKindConnection {
+					if query.PartialResults && len(events) > 0 {
+						publish(events, queryProgress{rows: rowCount - 1, skippedRows: skippedRows, partial: true})
+					}
+					return err
+				}
+				countError(err)
+				logp.Warn("Host %s query %v: row %d skipped: %v", h, queryLabel(i, query), rowCount, err)
+				skippedRows++
+			}
+
+			if len(events) >= bt.config.PublishBatchSize || batchBytes >= opts.batchBytes {
+				publish(events, queryProgress{rows: rowCount, skippedRows: skippedRows})
+				events = nil
+				batchBytes = 0
+			}
+		}
+		if err := rows.Err(); err != nil {
+			// The rows read before the failure are published when the query
+			// accepts partial results
+			if query.PartialResults && len(events) > 0 {
+				publish(events, queryProgress{rows: rowCount, skippedRows: skippedRows, partial: true})
 			}
+			return newCollectError(errorKindScan, err)
+		}
+
+		if len(events) > 0 {
+			publish(events, queryProgress{rows: rowCount, skippedRows: skippedRows})
 		}
 
-		return events, err
+		return nil
 
 	case queryTypeTwoColumns:
-		event, err := bt.generateEmptyEvent(queryType, dtNow)
+		event, err := bt.generateEmptyEvent(h, queryType, dtNow)
 		if err != nil {
-			return events, err
+			return err
 		}
 
 		for rows.Next() {
-			err := bt.appendRowToEvent(event, rows, columns, dtNow)
+			rowCount++
+			metricRowsFetched.Inc()
+			values, err := buffer.scan(rows)
+			if err != nil {
+				return newCollectError(errorKindScan, err)
+			}
+			err = bt.appendRowToEvent(h, event, values, columns, opts, dtNow)
 
 			if err != nil {
-				return events, err
+				return newCollectError(errorKindScan, err)
 			}
 		}
-
-		if event != nil {
-			events = append(events, event)
+		if err := rows.Err(); err != nil {
+			return newCollectError(errorKindScan, err)
 		}
 
-		return events, err
-	}
+		publish([]*beat.Event{event}, queryProgress{rows: rowCount})
 
-	err = fmt.Errorf("unknown query type: %v", queryType)
+		return nil
+	}
 
-	return events, err
+	return fmt.Errorf("unknown query type: %v", queryType)
 }
 
 // appendRowToEvent appends the two-column event the current row data
-func (bt *Mysqlbeat) appendRowToEvent(event *beat.Event, row *sql.Rows, columns []string, rowAge time.Time) error {
-
-	// Make a slice for the values
-	values := make([]sql.RawBytes, len(columns))
-
-	// Copy the references into such a []interface{} for row.Scan
-	scanArgs := make([]interface{}, len(values))
-	for i := range values {
-		scanArgs[i] = &values[i]
-	}
-
-	// Get RawBytes from data
-	err := row.Scan(scanArgs...)
-	if err != nil {
-		return err
-	}
+func (bt *Mysqlbeat) appendRowToEvent(h *host, event *beat.Event, values []sql.RawBytes, columns []string, opts columnOptions, rowAge time.Time) error {
 
 	// First column is the name, second is the value
 	strColName := string(values[0])
 	strColValue := string(values[1])
-	strColType := columnTypeString
-	strEventColName := strings.Replace(strColName, bt.config.DeltaWildcard, "_PERSECOND", 1)
+	strEventColName := fieldName(strings.Replace(strColName, bt.config.DeltaWildcard, "_PERSECOND", 1), opts)
 
-	// Try to parse the value to an int64
-	nColValue, err := strconv.ParseInt(strColValue, 0, 64)
-	if err == nil {
-		strColType = columnTypeInt
-	}
-
-	// Try to parse the value to a float64
-	fColValue, err := strconv.ParseFloat(strColValue, 64)
-	if err == nil {
-		// If it's not already an established int64, set type to float
-		if strColType == columnTypeString {
-			strColType = columnTypeFloat
+	// NULL values are handled according to the null_values setting
+	if values[1] == nil {
+		switch opts.nullValues {
+		case nullValuesOmit:
+			return nil
+		case nullValuesNull:
+			event.Fields[strEventColName] = nil
+			return nil
+		case nullValuesDefault:
+			strColValue = opts.nullDefault
 		}
 	}
 
+	// The value column of name/value pairs (such as SHOW GLOBAL STATUS) holds
+	// values of any type, they are parsed with the number heuristics and the
+	// settings of the column are those of the name
+	isDeltaColumn := strings.HasSuffix(strColName, bt.config.DeltaWildcard)
+	strColType, nColValue, fColValue, vColValue := parseColumnValue(strColName, strColValue, "", isDeltaColumn, opts)
+
 	// If the column name ends with the deltaWildcard
-	if strings.HasSuffix(strColName, bt.config.DeltaWildcard) {
+	if isDeltaColumn {
 		var exists bool
-		_, exists = bt.oldValues[strColName]
+		_, exists = h.oldValues[strColName]
 
 		// If an older value doesn't exist
 		if !exists {
 			// Save the current value in the oldValues array
-			bt.oldValuesAge[strColName] = rowAge
+			h.oldValuesAge[strColName] = rowAge
+			logp.Debug(deltaSelector, "Host %s delta %v: first value %v, no rate until the next cycle", h, strColName, strColValue)
 
 			if strColType == columnTypeString {
-				bt.oldValues[strColName] = strColValue
+				h.oldValues[strColName] = strColValue
 			} else if strColType == columnTypeInt {
-				bt.oldValues[strColName] = nColValue
+				h.oldValues[strColName] = nColValue
 			} else if strColType == columnTypeFloat {
-				bt.oldValues[strColName] = fColValue
+				h.oldValues[strColName] = fColValue
 			}
 		} else {
 			// If found the old value's age
-			if dtOldAge, ok := bt.oldValuesAge[strColName].(time.Time); ok {
+			if dtOldAge, ok := h.oldValuesAge[strColName].(time.Time); ok {
 				delta := rowAge.Sub(dtOldAge)
 
 				if strColType == columnTypeInt {
 					var calcVal int64
 
 					// Get old value
-					oldVal, _ := bt.oldValues[strColName].(int64)
+					oldVal, _ := h.oldValues[strColName].(int64)
 					if nColValue > oldVal {
 						// Calculate the delta
 						devResult := float64(nColValue-oldVal) / float64(delta.Seconds())
@@ -294,17 +1054,19 @@ func (bt *Mysqlbeat) appendRowToEvent(event *beat.Event, row *sql.Rows, columns
 						calcVal = 0
 					}
 
+					logDelta(h, strColName, oldVal, nColValue, delta, calcVal)
+
 					// Add the delta value to the event
 					event.Fields[strEventColName] = calcVal
 
 					// Save current values as old values
-					bt.oldValues[strColName] = nColValue
-					bt.oldValuesAge[strColName] = rowAge
+					h.oldValues[strColName] = nColValue
+					h.oldValuesAge[strColName] = rowAge
 				} else if strColType == columnTypeFloat {
 					var calcVal float64
 
 					// Get old value
-					oldVal, _ := bt.oldValues[strColName].(float64)
+					oldVal, _ := h.oldValues[strColName].(float64)
 					if fColValue > oldVal {
 						// Calculate the delta
 						calcVal = (fColValue - oldVal) / float64(delta.Seconds())
@@ -312,13 +1074,16 @@ func (bt *Mysqlbeat) appendRowToEvent(event *beat.Event, row *sql.Rows, columns
 						calcVal = 0
 					}
 
+					logDelta(h, strColName, oldVal, fColValue, delta, calcVal)
+
 					// Add the delta value to the event
 					event.Fields[strEventColName] = calcVal
 
 					// Save current values as old values
-					bt.oldValues[strColName] = fColValue
-					bt.oldValuesAge[strColName] = rowAge
+					h.oldValues[strColName] = fColValue
+					h.oldValuesAge[strColName] = rowAge
 				} else {
+					logp.Debug(deltaSelector, "Host %s delta %v: %v isn't a number, published as is", h, strColName, strColValue)
 					event.Fields[strEventColName] = strColValue
 				}
 			}
@@ -330,6 +1095,8 @@ func (bt *Mysqlbeat) appendRowToEvent(event *beat.Event, row *sql.Rows, columns
 			event.Fields[strEventColName] = nColValue
 		} else if strColType == columnTypeFloat {
 			event.Fields[strEventColName] = fColValue
+		} else if strColType == columnTypeOther {
+			event.Fields[strEventColName] = vColValue
 		}
 	}
 
@@ -337,37 +1104,51 @@ func (bt *Mysqlbeat) appendRowToEvent(event *beat.Event, row *sql.Rows, columns
 	return nil
 }
 
-func (bt *Mysqlbeat) generateEmptyEvent(queryType string, rowAge time.Time) (*beat.Event, error) {
+// setPipeline sets the ingest pipeline of an event, if any
+func setPipeline(event *beat.Event, pipeline string) {
+	if pipeline == "" {
+		return
+	}
+
+	if event.Meta == nil {
+		event.Meta = common.MapStr{}
+	}
+	event.Meta["pipeline"] = pipeline
+}
+
+func (bt *Mysqlbeat) generateEmptyEvent(h *host, queryType string, rowAge time.Time) (*beat.Event, error) {
 	event := &beat.Event{
 		Timestamp: rowAge,
 		Fields: common.MapStr{
-			"type": queryType,
+			"type":     queryType,
+			"hostname": h.config.Hostname,
+			"port":     h.config.Port,
 		},
 	}
+	if len(h.identity) > 0 {
+		event.Fields["instance"] = h.identity.Clone()
+	}
+
+	// Add the metadata of autodiscovered hosts
+	if h.meta != nil {
+		event.Fields.DeepUpdate(h.meta.Get())
+	}
 
 	return event, nil
 }
 
 // generateEventFromRow creates a new event from the row data and returns it
-func (bt *Mysqlbeat) generateEventFromRow(row *sql.Rows, columns []string, queryType string, rowAge time.Time) (*beat.Event, error) {
-	event, err := bt.generateEmptyEvent(queryType, rowAge)
+func (bt *Mysqlbeat) generateEventFromRow(h *host, values []sql.RawBytes, columns []string, dbTypes []string, queryType string, opts columnOptions, rowAge time.Time) (*beat.Event, error) {
+	event, err := bt.generateEmptyEvent(h, queryType, rowAge)
 	if err != nil {
 		return nil, err
 	}
+	emptyFields := len(event.Fields)
 
-	// Make a slice for the values
-	values := make([]sql.RawBytes, len(columns))
-
-	// Copy the references into such a []interface{} for row.Scan
-	scanArgs := make([]interface{}, len(values))
-	for i := range values {
-		scanArgs[i] = &values[i]
-	}
-
-	// Get RawBytes from data
-	err = row.Scan(scanArgs...)
-	if err != nil {
-		return nil, err
+	// Values of the document_id_columns
+	var idValues map[string]string
+	if len(opts.documentIDColumns) > 0 {
+		idValues = map[string]string{}
 	}
 
 	// Loop on all columns
@@ -375,7 +1156,20 @@ func (bt *Mysqlbeat) generateEventFromRow(row *sql.Rows, columns []string, query
 		// Get column name and string value
 		strColName := string(columns[i])
 		strColValue := string(col)
-		strColType := columnTypeString
+
+		if idValues != nil {
+			idValues[strColName] = strColValue
+		}
+
+		// The timestamp column sets the event timestamp, the events of the rows
+		// with a NULL or invalid timestamp keep the collection time
+		if strColName == opts.timestampColumn && col != nil {
+			if t, ok := parseTimestamp(strColValue, opts); ok {
+				event.Timestamp = t
+			} else {
+				logp.Debug("mysqlbeat", "Host %s invalid %v timestamp: %v", h, strColName, strColValue)
+			}
+		}
 
 		// Skip column processing when query type is show-slave-delay and the column isn't Seconds_Behind_Master
 		if queryType == queryTypeSlaveDelay && strColName != columnNameSlaveDelay {
@@ -391,24 +1185,32 @@ func (bt *Mysqlbeat) generateEventFromRow(row *sql.Rows, columns []string, query
 		} else if strings.HasSuffix(strColName, bt.config.DeltaWildcard) {
 			strEventColName = strings.Replace(strColName, bt.config.DeltaWildcard, "_PERSECOND", 1)
 		}
-
-		// Try to parse the value to an int64
-		nColValue, err := strconv.ParseInt(strColValue, 0, 64)
-		if err == nil {
-			strColType = columnTypeInt
+		strEventColName = fieldName(strEventColName, opts)
+
+		// NULL values are handled according to the null_values setting
+		if col == nil {
+			switch opts.nullValues {
+			case nullValuesOmit:
+				continue
+			case nullValuesNull:
+				event.Fields[strEventColName] = nil
+				continue
+			case nullValuesDefault:
+				strColValue = opts.nullDefault
+			}
 		}
 
-		// Try to parse the value to a float64
-		fColValue, err := strconv.ParseFloat(strColValue, 64)
-		if err == nil {
-			// If it's not already an established int64, set type to float
-			if strColType == columnTypeString {
-				strColType = columnTypeFloat
-			}
+		isDeltaColumn := (queryType == queryTypeSingleRow || queryType == queryTypeMultipleRows) && strings.HasSuffix(strColName, bt.config.DeltaWildcard)
+
+		// Convert the value according to the column type reported by the driver,
+		// delta columns are numbers even when they are selected as text
+		strColType, nColValue, fColValue, vColValue := parseColumnValue(strColName, strColValue, dbTypes[i], isDeltaColumn, opts)
+		if strColType == columnTypeSkip {
+			continue
 		}
 
 		// If the column name ends with the deltaWildcard
-		if (queryType == queryTypeSingleRow || queryType == queryTypeMultipleRows) && strings.HasSuffix(strColName, bt.config.DeltaWildcard) {
+		if isDeltaColumn {
 
 			var strKey string
 
@@ -427,30 +1229,31 @@ func (bt *Mysqlbeat) generateEventFromRow(row *sql.Rows, columns []string, query
 			}
 
 			var exists bool
-			_, exists = bt.oldValues[strKey]
+			_, exists = h.oldValues[strKey]
 
 			// If an older value doesn't exist
 			if !exists {
 				// Save the current value in the oldValues array
-				bt.oldValuesAge[strKey] = rowAge
+				h.oldValuesAge[strKey] = rowAge
+				logp.Debug(deltaSelector, "Host %s delta %v: first value %v, no rate until the next cycle", h, strKey, strColValue)
 
 				if strColType == columnTypeString {
-					bt.oldValues[strKey] = strColValue
+					h.oldValues[strKey] = strColValue
 				} else if strColType == columnTypeInt {
-					bt.oldValues[strKey] = nColValue
+					h.oldValues[strKey] = nColValue
 				} else if strColType == columnTypeFloat {
-					bt.oldValues[strKey] = fColValue
+					h.oldValues[strKey] = fColValue
 				}
 			} else {
 				// If found the old value's age
-				if dtOldAge, ok := bt.oldValuesAge[strKey].(time.Time); ok {
+				if dtOldAge, ok := h.oldValuesAge[strKey].(time.Time); ok {
 					delta := rowAge.Sub(dtOldAge)
 
 					if strColType == columnTypeInt {
 						var calcVal int64
 
 						// Get old value
-						oldVal, _ := bt.oldValues[strKey].(int64)
+						oldVal, _ := h.oldValues[strKey].(int64)
 
 						if nColValue > oldVal {
 							// Calculate the delta
@@ -461,15 +1264,17 @@ func (bt *Mysqlbeat) generateEventFromRow(row *sql.Rows, columns []string, query
 							calcVal = 0
 						}
 
+						logDelta(h, strKey, oldVal, nColValue, delta, calcVal)
+
 						// Add the delta value to the event
 						event.Fields[strEventColName] = calcVal
 
 						// Save current values as old values
-						bt.oldValues[strKey] = nColValue
-						bt.oldValuesAge[strKey] = rowAge
+						h.oldValues[strKey] = nColValue
+						h.oldValuesAge[strKey] = rowAge
 					} else if strColType == columnTypeFloat {
 						var calcVal float64
-						oldVal, _ := bt.oldValues[strKey].(float64)
+						oldVal, _ := h.oldValues[strKey].(float64)
 
 						if fColValue > oldVal {
 							// Calculate the delta
@@ -478,13 +1283,16 @@ func (bt *Mysqlbeat) generateEventFromRow(row *sql.Rows, columns []string, query
 							calcVal = 0
 						}
 
+						logDelta(h, strKey, oldVal, fColValue, delta, calcVal)
+
 						// Add the delta value to the event
 						event.Fields[strEventColName] = calcVal
 
 						// Save current values as old values
-						bt.oldValues[strKey] = fColValue
-						bt.oldValuesAge[strKey] = rowAge
+						h.oldValues[strKey] = fColValue
+						h.oldValuesAge[strKey] = rowAge
 					} else {
+						logp.Debug(deltaSelector, "Host %s delta %v: %v isn't a number, published as is", h, strKey, strColValue)
 						event.Fields[strEventColName] = strColValue
 					}
 				}
@@ -494,14 +1302,32 @@ func (bt *Mysqlbeat) generateEventFromRow(row *sql.Rows, columns []string, query
 				event.Fields[strEventColName] = strColValue
 			} else if strColType == columnTypeInt {
 				event.Fields[strEventColName] = nColValue
+
+				// The scaled decimals carry their scale in <field>_scale
+				if scale, ok := decimalScale(strColName, strColValue, dbTypes[i], opts); ok {
+					event.Fields[strEventColName+scaleSuffix] = scale
+				}
 			} else if strColType == columnTypeFloat {
 				event.Fields[strEventColName] = fColValue
+			} else if strColType == columnTypeOther {
+				event.Fields[strEventColName] = vColValue
 			}
 		}
 	}
 
+	applyGeoPoints(event.Fields, opts.geoPoints)
+
+	// Snapshot rows are updated instead of being indexed again
+	if idValues != nil {
+		if id, ok := documentID(opts.queryName, opts.documentIDColumns, idValues); ok {
+			event.SetID(id)
+		} else {
+			logp.Debug("mysqlbeat", "Host %s query %v rows miss document_id_columns", h, opts.queryName)
+		}
+	}
+
 	// If the event has no data, set to nil
-	if len(event.Fields) == 1 {
+	if len(event.Fields) == emptyFields {
 		event.Fields = nil
 	}
 
@@ -543,3 +1369,13 @@ func roundF2I(val float64, roundOn float64) (newVal int64) {
 
 	return int64(round)
 }
+
+// logDelta logs the computation of the rate of a delta key, so that the rates
+// of 0 (counter reset, unchanged value) can be told from the missing ones
+func logDelta(h *host, key string, oldVal, newVal interface{}, interval time.Duration, rate interface{}) {
+	if !logp.IsDebug(deltaSelector) {
+		return
+	}
+
+	logp.Debug(deltaSelector, "Host %s delta %v: old value %v, new value %v, interval %v, rate %v/s", h, key, oldVal, newVal, interval, rate)
+}
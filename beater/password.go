@@ -0,0 +1,49 @@
+package beater
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"fmt"
+)
+
+// passwordSecret and passwordIV are the AES-256 key and the CFB IV of the
+// encryptedpassword values, those of the original mysqlbeat and its password
+// encrypter. The encryption hides the password in the config file, it doesn't
+// protect it from anyone who can read the mysqlbeat source: use the keystore
+// for the secrets.
+const passwordSecret = "github.com/adibendahan/mysqlbeat"
+
+var passwordIV = []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+
+// EncryptPassword returns the encryptedpassword value of a password: the hex
+// encoding of the password encrypted with AES-CFB
+func EncryptPassword(password string) (string, error) {
+	block, err := aes.NewCipher([]byte(passwordSecret))
+	if err != nil {
+		return "", err
+	}
+
+	encrypted := make([]byte, len(password))
+	cipher.NewCFBEncrypter(block, passwordIV).XORKeyStream(encrypted, []byte(password))
+
+	return hex.EncodeToString(encrypted), nil
+}
+
+// decryptPassword returns the password of an encryptedpassword value
+func decryptPassword(encryptedPassword string) (string, error) {
+	encrypted, err := hex.DecodeString(encryptedPassword)
+	if err != nil {
+		return "", fmt.Errorf("invalid encryptedpassword: %v", err)
+	}
+
+	block, err := aes.NewCipher([]byte(passwordSecret))
+	if err != nil {
+		return "", err
+	}
+
+	password := make([]byte, len(encrypted))
+	cipher.NewCFBDecrypter(block, passwordIV).XORKeyStream(password, encrypted)
+
+	return string(password), nil
+}
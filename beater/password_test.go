@@ -0,0 +1,27 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+)
+
+func TestEncryptPassword(t *testing.T) {
+	encrypted, err := EncryptPassword("mysqlbeat_pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The encryptedpassword example of the config
+	if encrypted != "2321f38819cf693951e88f00cd82" {
+		t.Errorf("encrypted password: %v", encrypted)
+	}
+
+	password, err := decryptPassword(encrypted)
+	if err != nil || password != "mysqlbeat_pass" {
+		t.Errorf("decrypted password: %q, %v", password, err)
+	}
+
+	if _, err := decryptPassword("not hex"); err == nil {
+		t.Errorf("invalid encryptedpassword decrypted")
+	}
+}
@@ -0,0 +1,96 @@
+package beater
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/elastic/beats/libbeat/beat"
+)
+
+// DryRun is the file of the events of a dry run (--dry-run), - is stdout.
+// The beat runs its normal schedule but writes the events to it as NDJSON
+// instead of publishing them to the configured output.
+var DryRun string
+
+// printPipeline is the pipeline of the commands and of the dry run, its
+// clients print the events as JSON instead of publishing them: pretty JSON,
+// or one event per line (NDJSON)
+type printPipeline struct {
+	mutex  sync.Mutex
+	w      io.Writer
+	pretty bool
+	err    error
+}
+
+func (p *printPipeline) Connect() (beat.Client, error) {
+	return p.ConnectWith(beat.ClientConfig{})
+}
+
+func (p *printPipeline) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
+	return &printClient{pipeline: p, acked: cfg.ACKCount}, nil
+}
+
+// printClient prints the events of a host, they are acknowledged as soon as
+// they are printed
+type printClient struct {
+	pipeline *printPipeline
+	acked    func(int)
+}
+
+func (c *printClient) Publish(event beat.Event) {
+	c.PublishAll([]beat.Event{event})
+}
+
+func (c *printClient) PublishAll(events []beat.Event) {
+	p := c.pipeline
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for _, event := range events {
+		fields := event.Fields.Clone()
+		fields["@timestamp"] = event.Timestamp
+		if len(event.Meta) > 0 {
+			fields["@metadata"] = event.Meta
+		}
+
+		var data []byte
+		var err error
+		if p.pretty {
+			data, err = json.MarshalIndent(fields, "", "  ")
+		} else {
+			data, err = json.Marshal(fields)
+		}
+		if err != nil {
+			data = []byte(fmt.Sprintf(`{"error": %q}`, err.Error()))
+		}
+		if _, err := fmt.Fprintf(p.w, "%s\n", data); err != nil && p.err == nil {
+			p.err = err
+		}
+	}
+
+	if c.acked != nil {
+		c.acked(len(events))
+	}
+}
+
+func (c *printClient) Close() error {
+	return nil
+}
+
+// newDryRunPipeline returns the pipeline of a dry run, the events are
+// appended to the file (- is stdout)
+func newDryRunPipeline(path string) (beat.Pipeline, io.Closer, error) {
+	if path == "-" {
+		return &printPipeline{w: os.Stdout}, nil, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &printPipeline{w: file}, file, nil
+}
@@ -0,0 +1,47 @@
+// +build !integration
+
+package beater
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func TestPrintClient(t *testing.T) {
+	var out bytes.Buffer
+	acked := 0
+	pipeline := &printPipeline{w: &out}
+	client, _ := pipeline.ConnectWith(beat.ClientConfig{ACKCount: func(n int) { acked += n }})
+
+	timestamp := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	client.PublishAll([]beat.Event{
+		{Timestamp: timestamp, Fields: common.MapStr{"type": "status", "threads": 3}},
+		{Timestamp: timestamp, Fields: common.MapStr{"type": "status", "threads": 4}},
+	})
+
+	if acked != 2 {
+		t.Errorf("%d events acknowledged, expected 2", acked)
+	}
+
+	// One event per line (NDJSON)
+	if lines := strings.Count(out.String(), "\n"); lines != 2 {
+		t.Errorf("%d lines printed, expected 2", lines)
+	}
+
+	decoder := json.NewDecoder(&out)
+	for _, threads := range []float64{3, 4} {
+		var event map[string]interface{}
+		if err := decoder.Decode(&event); err != nil {
+			t.Fatalf("printed event: %v", err)
+		}
+		if event["threads"] != threads || event["@timestamp"] != "2020-01-02T03:04:05Z" {
+			t.Errorf("printed event: %v", event)
+		}
+	}
+}
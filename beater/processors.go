@@ -0,0 +1,81 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/processors"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+// newMetadataProcessors creates the add_host_metadata and add_cloud_metadata
+// processors enabled by the host_metadata and cloud_metadata settings, they
+// run on the events of every host before the processors of the beat config
+func newMetadataProcessors(c config.Config) (beat.ProcessorList, error) {
+	var names []string
+	if c.HostMetadata {
+		names = append(names, "add_host_metadata")
+	}
+	if c.CloudMetadata {
+		names = append(names, "add_cloud_metadata")
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	var plugins []map[string]interface{}
+	for _, name := range names {
+		plugins = append(plugins, map[string]interface{}{name: map[string]interface{}{}})
+	}
+
+	cfg, err := common.NewConfigFrom(plugins)
+	if err != nil {
+		return nil, err
+	}
+
+	var pluginConfig processors.PluginConfig
+	if err := cfg.Unpack(&pluginConfig); err != nil {
+		return nil, err
+	}
+
+	list, err := processors.New(pluginConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the metadata processors: %v", err)
+	}
+
+	return list, nil
+}
+
+// connect creates the publisher client of a host, which adds the fields and
+// tags settings, runs the metadata processors and counts the acknowledged
+// events. The publishing of a blocked client returns when the beat is
+// stopped, so that Stop doesn't wait for an unreachable output.
+func (bt *Mysqlbeat) connect(p beat.Pipeline) (beat.Client, error) {
+	return p.ConnectWith(beat.ClientConfig{
+		Processing: beat.ProcessingConfig{
+			EventMetadata: bt.config.EventMetadata,
+			Processor:     bt.processors,
+		},
+		CloseRef: stopRef(bt.done),
+		ACKCount: bt.acked,
+	})
+}
+
+// stopRef is the CloseRef of the clients, closed when the beat is stopped
+type stopRef <-chan struct{}
+
+func (r stopRef) Done() <-chan struct{} {
+	return r
+}
+
+func (r stopRef) Err() error {
+	select {
+	case <-r:
+		return context.Canceled
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+package beater
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+// defaultPrometheusHost is the listen address of the Prometheus endpoint
+const defaultPrometheusHost = "localhost:9479"
+
+// startPrometheus starts the HTTP listener serving the metrics of the beat
+// (the libbeat and mysqlbeat metrics, not the MySQL data) in the Prometheus
+// exposition format on /metrics, the returned server is closed when the beat
+// stops
+func startPrometheus(c config.Prometheus) (*http.Server, error) {
+	host := c.Host
+	if host == "" {
+		host = defaultPrometheusHost
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		w.Write(prometheusMetrics(monitoring.CollectFlatSnapshot(monitoring.Default, monitoring.Reported, false)))
+	})
+
+	listener, err := net.Listen("tcp", host)
+	if err != nil {
+		return nil, err
+	}
+
+	server := &http.Server{Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logp.Err("Prometheus endpoint: %v", err)
+		}
+	}()
+
+	logp.Info("Prometheus endpoint listening on http://%s/metrics", listener.Addr())
+	return server, nil
+}
+
+// prometheusMetrics formats the numeric and boolean metrics of a snapshot,
+// sorted by name. The metrics are untyped, the registry doesn't tell the
+// counters from the gauges.
+func prometheusMetrics(snapshot monitoring.FlatSnapshot) []byte {
+	values := map[string]string{}
+	for name, value := range snapshot.Ints {
+		values[prometheusName(name)] = fmt.Sprintf("%d", value)
+	}
+	for name, value := range snapshot.Floats {
+		values[prometheusName(name)] = fmt.Sprintf("%g", value)
+	}
+	for name, value := range snapshot.Bools {
+		if value {
+			values[prometheusName(name)] = "1"
+		} else {
+			values[prometheusName(name)] = "0"
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buffer bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&buffer, "# TYPE %s untyped\n%s %s\n", name, name, values[name])
+	}
+
+	return buffer.Bytes()
+}
+
+// prometheusName returns the Prometheus name of a metric: its dotted name
+// with the invalid characters replaced, in the mysqlbeat namespace
+func prometheusName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+
+	if !strings.HasPrefix(name, "mysqlbeat_") {
+		name = "mysqlbeat_" + name
+	}
+
+	return name
+}
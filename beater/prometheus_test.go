@@ -0,0 +1,30 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	snapshot := monitoring.FlatSnapshot{
+		Ints:   map[string]int64{"mysqlbeat.queries.executed": 12, "libbeat.output.events.acked": 40},
+		Floats: map[string]float64{"system.load.1": 0.5},
+		Bools:  map[string]bool{"beat.info.ephemeral": true},
+	}
+
+	expected := `# TYPE mysqlbeat_beat_info_ephemeral untyped
+mysqlbeat_beat_info_ephemeral 1
+# TYPE mysqlbeat_libbeat_output_events_acked untyped
+mysqlbeat_libbeat_output_events_acked 40
+# TYPE mysqlbeat_queries_executed untyped
+mysqlbeat_queries_executed 12
+# TYPE mysqlbeat_system_load_1 untyped
+mysqlbeat_system_load_1 0.5
+`
+	if metrics := string(prometheusMetrics(snapshot)); metrics != expected {
+		t.Errorf("metrics:\n%s\nexpected:\n%s", metrics, expected)
+	}
+}
@@ -0,0 +1,157 @@
+package beater
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"golang.org/x/net/proxy"
+)
+
+const defaultProxyTimeout = 10 * time.Second
+
+// contextDialer is a proxy dialer that can be canceled, the proxy.ContextDialer
+// of the recent golang.org/x/net releases
+type contextDialer interface {
+	DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// registerProxy registers the driver network that dials the MySQL servers
+// through a SOCKS5 or HTTP CONNECT proxy
+func registerProxy(proxyURL string) (string, error) {
+	return registerDialer("proxy:"+proxyURL, func() (mysql.DialContextFunc, error) {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %v", err)
+		}
+
+		switch u.Scheme {
+		case "socks5":
+			dialer, err := proxy.FromURL(u, &net.Dialer{Timeout: defaultProxyTimeout})
+			if err != nil {
+				return nil, err
+			}
+
+			return func(ctx context.Context, addr string) (net.Conn, error) {
+				return dialSOCKSProxy(ctx, dialer, addr)
+			}, nil
+
+		case "http":
+			return func(ctx context.Context, addr string) (net.Conn, error) {
+				return dialHTTPProxy(ctx, u, addr)
+			}, nil
+		}
+
+		return nil, fmt.Errorf("unsupported proxy_url scheme: %v", u.Scheme)
+	})
+}
+
+// dialSOCKSProxy dials addr through a SOCKS5 proxy, the dial is abandoned
+// when ctx is done. The dialers without DialContext dial in the background,
+// their late connections are closed.
+func dialSOCKSProxy(ctx context.Context, dialer proxy.Dialer, addr string) (net.Conn, error) {
+	if d, ok := dialer.(contextDialer); ok {
+		return d.DialContext(ctx, "tcp", addr)
+	}
+
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		conn, err := dialer.Dial("tcp", addr)
+		done <- result{conn, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.conn, r.err
+	case <-ctx.Done():
+		go func() {
+			if r := <-done; r.conn != nil {
+				r.conn.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// bufferedConn is a connection whose first bytes were read by a bufio.Reader,
+// the reads drain it before reading from the connection
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	if c.reader.Buffered() > 0 {
+		return c.reader.Read(b)
+	}
+
+	return c.Conn.Read(b)
+}
+
+// dialHTTPProxy opens a tunnel to addr with an HTTP CONNECT request. The
+// MySQL server speaks first: its handshake can arrive with the CONNECT
+// response, in the buffer of the response reader.
+func dialHTTPProxy(ctx context.Context, u *url.URL, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: defaultProxyTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", u.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	// A proxy that never replies doesn't block the dial
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultProxyTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if u.User != nil {
+		password, _ := u.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(u.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %v refused the connection to %v: %v", u.Host, addr, resp.Status)
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
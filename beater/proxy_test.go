@@ -0,0 +1,75 @@
+// +build !integration
+
+package beater
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestDialHTTPProxyKeepsHandshake(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	// The proxy sends the CONNECT response and the server handshake in one write
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if _, err := http.ReadRequest(bufio.NewReader(conn)); err != nil {
+			return
+		}
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\nhandshake"))
+		time.Sleep(time.Second)
+	}()
+
+	u := &url.URL{Scheme: "http", Host: listener.Addr().String()}
+	conn, err := dialHTTPProxy(context.Background(), u, "db:3306")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	handshake := make([]byte, len("handshake"))
+	if _, err := io.ReadFull(conn, handshake); err != nil || string(handshake) != "handshake" {
+		t.Errorf("handshake %q, %v", handshake, err)
+	}
+}
+
+func TestDialHTTPProxyTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	// The proxy never replies
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		time.Sleep(2 * time.Second)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	u := &url.URL{Scheme: "http", Host: listener.Addr().String()}
+	if _, err := dialHTTPProxy(ctx, u, "db:3306"); err == nil {
+		t.Errorf("dial through a silent proxy succeeded")
+	}
+}
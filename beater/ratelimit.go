@@ -0,0 +1,106 @@
+package beater
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// rate_limit_policy values
+	rateLimitDrop  = "drop"
+	rateLimitDelay = "delay"
+)
+
+// rateLimiter caps the events published per second, it holds up to one second
+// of events
+type rateLimiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter creates a limiter of rate events per second, nil when the
+// rate is 0 (unlimited)
+func newRateLimiter(rate float64) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{rate: rate, tokens: rate, last: time.Now()}
+}
+
+// refill adds the tokens of the time elapsed since the last call
+func (l *rateLimiter) refill(now time.Time) {
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+}
+
+// allow returns how many of n events can be published now, the others must be
+// dropped
+func (l *rateLimiter) allow(n int) int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.refill(time.Now())
+	if l.tokens < 0 {
+		return 0
+	}
+
+	allowed := n
+	if float64(allowed) > l.tokens {
+		allowed = int(l.tokens)
+	}
+	l.tokens -= float64(allowed)
+
+	return allowed
+}
+
+// wait waits until n events can be published, it returns false when the
+// context is done first
+func (l *rateLimiter) wait(ctx context.Context, n int) bool {
+	l.mutex.Lock()
+	l.refill(time.Now())
+	l.tokens -= float64(n)
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mutex.Unlock()
+
+	if delay <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// limitEvents applies the limiters to a batch of n events with the policy, it
+// returns the number of events to publish
+func limitEvents(ctx context.Context, n int, policy string, limiters ...*rateLimiter) int {
+	for _, l := range limiters {
+		if l == nil {
+			continue
+		}
+
+		if policy == rateLimitDelay {
+			if !l.wait(ctx, n) {
+				return 0
+			}
+			continue
+		}
+
+		n = l.allow(n)
+	}
+
+	return n
+}
@@ -0,0 +1,42 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterDrop(t *testing.T) {
+	l := newRateLimiter(10)
+	if allowed := limitEvents(context.Background(), 25, rateLimitDrop, l); allowed != 10 {
+		t.Errorf("expected 10 events, got %v", allowed)
+	}
+	if allowed := limitEvents(context.Background(), 5, rateLimitDrop, l); allowed != 0 {
+		t.Errorf("expected the events to be dropped, got %v", allowed)
+	}
+}
+
+func TestRateLimiterDelay(t *testing.T) {
+	l := newRateLimiter(100)
+	start := time.Now()
+	if allowed := limitEvents(context.Background(), 110, rateLimitDelay, l); allowed != 110 {
+		t.Errorf("expected 110 events, got %v", allowed)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected a delay of 100ms, got %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if allowed := limitEvents(ctx, 1000, rateLimitDelay, l); allowed != 0 {
+		t.Errorf("expected no events when the context is done, got %v", allowed)
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	if allowed := limitEvents(context.Background(), 1000, rateLimitDrop, newRateLimiter(0)); allowed != 1000 {
+		t.Errorf("expected 1000 events, got %v", allowed)
+	}
+}
@@ -0,0 +1,67 @@
+package beater
+
+import (
+	"database/sql"
+	"sync"
+)
+
+// rowBuffer holds the scan destinations of the rows of a query, they are
+// reused by every row of the query and, through rowBuffers, by the next
+// queries and cycles
+type rowBuffer struct {
+	values   []sql.RawBytes
+	scanArgs []interface{}
+}
+
+// rowBuffers are the row buffers that aren't in use
+var rowBuffers = sync.Pool{
+	New: func() interface{} {
+		return &rowBuffer{}
+	},
+}
+
+// getRowBuffer returns a row buffer for rows of n columns, it must be put back
+// with putRowBuffer
+func getRowBuffer(n int) *rowBuffer {
+	b := rowBuffers.Get().(*rowBuffer)
+	if cap(b.values) < n {
+		b.values = make([]sql.RawBytes, n)
+		b.scanArgs = make([]interface{}, n)
+	}
+	b.values = b.values[:n]
+	b.scanArgs = b.scanArgs[:n]
+
+	// Copy the references into such a []interface{} for rows.Scan
+	for i := range b.values {
+		b.scanArgs[i] = &b.values[i]
+	}
+
+	return b
+}
+
+// putRowBuffer releases a row buffer
+func putRowBuffer(b *rowBuffer) {
+	for i := range b.values {
+		b.values[i] = nil
+	}
+	rowBuffers.Put(b)
+}
+
+// scan reads the current row, the values are only valid until the next scan
+func (b *rowBuffer) scan(rows *sql.Rows) ([]sql.RawBytes, error) {
+	if err := rows.Scan(b.scanArgs...); err != nil {
+		return nil, err
+	}
+
+	return b.values, nil
+}
+
+// size returns the number of bytes of the values of the current row
+func (b *rowBuffer) size() int {
+	size := 0
+	for _, value := range b.values {
+		size += len(value)
+	}
+
+	return size
+}
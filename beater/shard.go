@@ -0,0 +1,138 @@
+package beater
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+const (
+	// shard by values
+	shardByHost  = "host"
+	shardByQuery = "query"
+
+	// shardPoolKey is the shard key of the replica pool hosts, the any_replica
+	// queries run on a single host of the pool
+	shardPoolKey = "replica_pool"
+)
+
+// checkShard validates the shard settings
+func checkShard(s *config.Shard) error {
+	if s == nil {
+		return nil
+	}
+	if s.Instance >= s.Instances {
+		return fmt.Errorf("shard instance %d is not lower than the %d instances", s.Instance, s.Instances)
+	}
+
+	switch s.By {
+	case "", shardByHost, shardByQuery:
+	default:
+		return fmt.Errorf("unknown shard by: %v", s.By)
+	}
+
+	return nil
+}
+
+// ownsShard reports whether the key belongs to the shard of this instance.
+// The keys are assigned with rendezvous hashing, changing the number of
+// instances only moves the keys of the added or removed instances.
+func ownsShard(s *config.Shard, key string) bool {
+	if s == nil {
+		return true
+	}
+
+	owner, best := 0, uint64(0)
+	for i := 0; i < s.Instances; i++ {
+		hash := fnv.New64a()
+		hash.Write([]byte(key + "/" + strconv.Itoa(i)))
+		if score := hash.Sum64(); i == 0 || score > best {
+			owner, best = i, score
+		}
+	}
+
+	return owner == s.Instance
+}
+
+// shardQueries returns the queries of a host that belong to the shard of this
+// instance. The queries are sharded with the host, or one by one with the
+// queries they depend on in the same cycle.
+func shardQueries(s *config.Shard, hostKey string, queries []config.Query) []config.Query {
+	if s == nil {
+		return queries
+	}
+	if s.By != shardByQuery {
+		if ownsShard(s, hostKey) {
+			return queries
+		}
+		return nil
+	}
+
+	// The queries linked by their dependencies share the key of the first one
+	roots := make([]int, len(queries))
+	index := map[string]int{}
+	var root func(i int) int
+	root = func(i int) int {
+		if roots[i] != i {
+			roots[i] = root(roots[i])
+		}
+		return roots[i]
+	}
+	for i, query := range queries {
+		roots[i] = i
+		if query.Name != "" {
+			index[query.Name] = i
+		}
+	}
+	for i, query := range queries {
+		for _, dep := range query.DependsOn {
+			if j, ok := index[dep]; ok {
+				a, b := root(i), root(j)
+				if a > b {
+					a, b = b, a
+				}
+				roots[b] = a
+			}
+		}
+	}
+
+	var selected []config.Query
+	for i, query := range queries {
+		if ownsShard(s, hostKey+"/"+queryLabel(root(i), queries[root(i)])) {
+			selected = append(selected, query)
+		}
+	}
+
+	return selected
+}
+
+// shardModules returns the modules of a host that belong to the shard of this
+// instance
+func shardModules(s *config.Shard, hostKey string, modules []*hostModule) []*hostModule {
+	var selected []*hostModule
+	for _, m := range modules {
+		key := hostKey
+		if s != nil && s.By == shardByQuery {
+			key += "/module " + m.name
+		}
+		if ownsShard(s, key) {
+			selected = append(selected, m)
+		}
+	}
+
+	return selected
+}
+
+// hostShardKey returns the shard key of a host: its name, or its address
+func hostShardKey(hc config.Host, runOn string) string {
+	if runOn == queryRunOnAnyReplica {
+		return shardPoolKey
+	}
+	if hc.Name != "" {
+		return hc.Name
+	}
+
+	return hc.Hostname + ":" + hc.Port
+}
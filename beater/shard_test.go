@@ -0,0 +1,82 @@
+// +build !integration
+
+package beater
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+func TestOwnsShard(t *testing.T) {
+	for k := 0; k < 100; k++ {
+		key := fmt.Sprintf("db%d.example.com:3306", k)
+
+		owners := 0
+		for i := 0; i < 3; i++ {
+			if ownsShard(&config.Shard{Instance: i, Instances: 3}, key) {
+				owners++
+			}
+		}
+		if owners != 1 {
+			t.Errorf("%s: %d owners", key, owners)
+		}
+	}
+
+	if !ownsShard(nil, "db1.example.com:3306") {
+		t.Errorf("no sharding: key not owned")
+	}
+}
+
+func TestShardQueries(t *testing.T) {
+	queries := []config.Query{
+		{Name: "snapshot"},
+		{Name: "read", DependsOn: []string{"snapshot"}},
+		{Name: "status"},
+		{Name: "variables"},
+		{Name: "processlist"},
+	}
+
+	total := 0
+	for i := 0; i < 3; i++ {
+		selected := shardQueries(&config.Shard{Instance: i, Instances: 3, By: shardByQuery}, "orders", queries)
+		total += len(selected)
+
+		names := map[string]bool{}
+		for _, query := range selected {
+			names[query.Name] = true
+		}
+		if names["snapshot"] != names["read"] {
+			t.Errorf("instance %d: dependent queries split: %v", i, names)
+		}
+	}
+	if total != len(queries) {
+		t.Errorf("%d queries collected, expected %d", total, len(queries))
+	}
+
+	total = 0
+	for i := 0; i < 3; i++ {
+		selected := shardQueries(&config.Shard{Instance: i, Instances: 3}, "orders", queries)
+		if len(selected) != 0 && len(selected) != len(queries) {
+			t.Errorf("instance %d: host queries split: %d", i, len(selected))
+		}
+		total += len(selected)
+	}
+	if total != len(queries) {
+		t.Errorf("%d queries collected, expected %d", total, len(queries))
+	}
+}
+
+func TestCheckShard(t *testing.T) {
+	for _, s := range []*config.Shard{nil, {Instance: 0, Instances: 1}, {Instance: 2, Instances: 3, By: shardByQuery}} {
+		if err := checkShard(s); err != nil {
+			t.Errorf("%+v: %v", s, err)
+		}
+	}
+	for _, s := range []*config.Shard{{Instance: 3, Instances: 3}, {Instance: 0, Instances: 2, By: "table"}} {
+		if err := checkShard(s); err == nil {
+			t.Errorf("%+v: no error", s)
+		}
+	}
+}
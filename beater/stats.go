@@ -0,0 +1,145 @@
+package beater
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+// queryStats is the last run, the last success and the last failure of a
+// query on a host and the latency histogram of its runs, reported by the
+// stats endpoint of the beat so that the health checks can verify the
+// collection
+type queryStats struct {
+	lastRun      time.Time
+	lastDuration time.Duration
+	lastRows     int
+	lastSuccess  time.Time
+	lastFailure  time.Time
+	lastError    string
+	latency      latencyHistogram
+}
+
+// hostStats is the last runs of the queries of a host, by query index
+type hostStats struct {
+	mutex   sync.Mutex
+	queries []queryStats
+}
+
+// record records a run of a query
+func (s *hostStats) record(i int, start time.Time, rows int, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stats := &s.queries[i]
+	stats.lastRun = start
+	stats.lastDuration = time.Since(start)
+	stats.lastRows = rows
+	if err == nil {
+		stats.lastSuccess = start
+	} else {
+		stats.lastFailure = start
+		stats.lastError = err.Error()
+	}
+	stats.latency.observe(stats.lastDuration)
+}
+
+// logLatencies logs the latency histograms of the queries of a host
+func (h *host) logLatencies() {
+	h.stats.mutex.Lock()
+	defer h.stats.mutex.Unlock()
+
+	for i, query := range h.queries {
+		if latency := h.stats.queries[i].latency; latency.count > 0 {
+			logp.Debug("mysqlbeat", "Host %s query %v latency: %v", h, queryLabel(i, query), &latency)
+		}
+	}
+}
+
+// registerQueryStats reports the last runs of the queries of the hosts in the
+// mysqlbeat.queries stats, they are served by the http endpoint of the beat
+// (/stats) but aren't sent by the monitoring. The stats of a previous beat
+// instance are replaced.
+func (bt *Mysqlbeat) registerQueryStats() {
+	metrics.Remove("queries")
+	monitoring.NewFunc(metrics, "queries", func(_ monitoring.Mode, V monitoring.Visitor) {
+		V.OnRegistryStart()
+		defer V.OnRegistryFinished()
+
+		for _, h := range bt.activeHosts() {
+			monitoring.ReportNamespace(V, h.String(), func() { h.reportStats(V) })
+		}
+		if len(bt.pool) > 0 {
+			monitoring.ReportNamespace(V, "replica_pool", func() {
+				for _, h := range bt.pool {
+					monitoring.ReportNamespace(V, h.String(), func() { h.reportStats(V) })
+				}
+			})
+		}
+	}, monitoring.DoNotReport)
+}
+
+// registerPoolStats reports the connection pools of the hosts in the
+// mysqlbeat.pools stats, so that the exhaustion of the pool or the leak of
+// connections by a host can be found. The monitoring sends the totals of the
+// mysqlbeat.pool metrics.
+func (bt *Mysqlbeat) registerPoolStats() {
+	metrics.Remove("pools")
+	monitoring.NewFunc(metrics, "pools", func(_ monitoring.Mode, V monitoring.Visitor) {
+		V.OnRegistryStart()
+		defer V.OnRegistryFinished()
+
+		for _, h := range append(bt.activeHosts(), bt.pool...) {
+			stats := h.poolStats()
+			monitoring.ReportNamespace(V, h.String(), func() {
+				monitoring.ReportInt(V, "max_open", int64(stats.MaxOpenConnections))
+				monitoring.ReportInt(V, "open", int64(stats.OpenConnections))
+				monitoring.ReportInt(V, "in_use", int64(stats.InUse))
+				monitoring.ReportInt(V, "idle", int64(stats.Idle))
+				monitoring.ReportInt(V, "wait_count", stats.WaitCount)
+				monitoring.ReportInt(V, "wait_duration_ms", stats.WaitDuration.Nanoseconds()/int64(time.Millisecond))
+				monitoring.ReportInt(V, "max_idle_closed", stats.MaxIdleClosed)
+				monitoring.ReportInt(V, "max_lifetime_closed", stats.MaxLifetimeClosed)
+			})
+		}
+	}, monitoring.DoNotReport)
+}
+
+// reportStats reports the last runs of the queries of a host
+func (h *host) reportStats(V monitoring.Visitor) {
+	h.stats.mutex.Lock()
+	defer h.stats.mutex.Unlock()
+
+	for i, query := range h.queries {
+		stats := h.stats.queries[i]
+		monitoring.ReportNamespace(V, queryLabel(i, query), func() {
+			if stats.lastRun.IsZero() {
+				return
+			}
+			reportTime(V, "last_run", stats.lastRun)
+			monitoring.ReportInt(V, "last_duration_ms", stats.lastDuration.Nanoseconds()/int64(time.Millisecond))
+			monitoring.ReportInt(V, "last_rows", int64(stats.lastRows))
+			reportTime(V, "last_success", stats.lastSuccess)
+			reportTime(V, "last_failure", stats.lastFailure)
+			monitoring.ReportString(V, "last_error", stats.lastError)
+			monitoring.ReportNamespace(V, "latency", func() {
+				monitoring.ReportInt(V, "count", stats.latency.count)
+				monitoring.ReportInt(V, "mean_ms", stats.latency.mean().Nanoseconds()/int64(time.Millisecond))
+				monitoring.ReportInt(V, "max_ms", stats.latency.max.Nanoseconds()/int64(time.Millisecond))
+				for b, n := range stats.latency.counts {
+					monitoring.ReportInt(V, bucketName(b), n)
+				}
+			})
+		})
+	}
+}
+
+// reportTime reports a time of the stats in RFC 3339, the zero times aren't
+// reported
+func reportTime(V monitoring.Visitor, name string, t time.Time) {
+	if !t.IsZero() {
+		monitoring.ReportString(V, name, t.UTC().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,195 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+const (
+	// testDBTimeout bounds every check of the test db command
+	testDBTimeout = 10 * time.Second
+
+	// errAccessDenied is the error of the failed authentications
+	errAccessDenied = 1045
+)
+
+// requiredPrivilege is a global privilege checked by the test db command and
+// what needs it
+type requiredPrivilege struct {
+	name   string
+	reason string
+}
+
+var requiredPrivileges = []requiredPrivilege{
+	{"PROCESS", "the processlist and deadlock modules, SHOW ENGINE INNODB STATUS"},
+	{"REPLICATION CLIENT", "the replication and binlog modules, the show-slave-delay queries"},
+}
+
+// TestDB checks every host and replica pool host of the config: the DNS
+// resolution of its hostname, the TCP connection, the authentication, the
+// TLS encryption of the session and the privileges the modules need. The
+// results and the fixes of the failures are printed to w, TestDB returns an
+// error when a check failed.
+func TestDB(cfg *common.Config, w io.Writer) error {
+	bt, err := newCommandBeat(cfg, &beat.Beat{Publisher: &printPipeline{w: ioutil.Discard}})
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, h := range append(append([]*host{}, bt.hosts...), bt.pool...) {
+		if !testHost(h, w) {
+			failed++
+		}
+		h.close()
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d hosts failed the checks", failed)
+	}
+
+	return nil
+}
+
+// testHost runs the checks of a host, the checks stop at the first failure
+// of the connectivity
+func testHost(h *host, w io.Writer) bool {
+	fmt.Fprintf(w, "host %s...\n", h)
+	addr := net.JoinHostPort(h.config.Hostname, h.config.Port)
+
+	// The ssh tunnels and the proxies resolve and connect to the hostname
+	// themselves
+	if h.network == "tcp" {
+		ctx, cancel := context.WithTimeout(context.Background(), testDBTimeout)
+		addrs, err := net.DefaultResolver.LookupHost(ctx, h.config.Hostname)
+		cancel()
+		if err != nil {
+			fmt.Fprintf(w, "  dns... ERROR %v\n", err)
+			fmt.Fprintf(w, "    check the hostname setting and the DNS configuration of this machine\n")
+			return false
+		}
+		fmt.Fprintf(w, "  dns... OK %s\n", strings.Join(addrs, ", "))
+
+		conn, err := net.DialTimeout("tcp", addr, testDBTimeout)
+		if err != nil {
+			fmt.Fprintf(w, "  tcp... ERROR %v\n", err)
+			fmt.Fprintf(w, "    check the port setting, that the server listens on %s (bind_address) and the firewalls\n", addr)
+			return false
+		}
+		conn.Close()
+		fmt.Fprintf(w, "  tcp... OK\n")
+	} else {
+		fmt.Fprintf(w, "  dns, tcp... resolved and connected by the ssh_tunnel or proxy_url\n")
+	}
+
+	db, err := h.open()
+	if err != nil {
+		fmt.Fprintf(w, "  authentication... ERROR %v\n", err)
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testDBTimeout)
+	defer cancel()
+
+	var user string
+	if err := db.QueryRowContext(ctx, "SELECT CURRENT_USER()").Scan(&user); err != nil {
+		fmt.Fprintf(w, "  authentication... ERROR %v\n", err)
+		if mysqlErr, ok := errorCause(err).(*mysql.MySQLError); ok && mysqlErr.Number == errAccessDenied {
+			fmt.Fprintf(w, "    check the username and the password (or encryptedpassword) settings of the host\n")
+		} else if isBrokenConn(err) {
+			fmt.Fprintf(w, "    the server closed the connection: check max_connections and that the host is allowed to connect\n")
+		}
+		return false
+	}
+	fmt.Fprintf(w, "  authentication... OK %s\n", user)
+
+	var name, cipher string
+	if err := db.QueryRowContext(ctx, "SHOW SESSION STATUS LIKE 'Ssl_cipher'").Scan(&name, &cipher); err != nil {
+		fmt.Fprintf(w, "  tls... WARN %v\n", err)
+	} else if cipher == "" {
+		fmt.Fprintf(w, "  tls... WARN the session isn't encrypted\n")
+	} else {
+		fmt.Fprintf(w, "  tls... OK %s\n", cipher)
+	}
+
+	rows, err := db.QueryContext(ctx, "SHOW GRANTS")
+	if err != nil {
+		fmt.Fprintf(w, "  privileges... ERROR %v\n", err)
+		return false
+	}
+	defer rows.Close()
+
+	var grants []string
+	for rows.Next() {
+		var grant string
+		if err := rows.Scan(&grant); err != nil {
+			fmt.Fprintf(w, "  privileges... ERROR %v\n", err)
+			return false
+		}
+		grants = append(grants, grant)
+	}
+	if err := rows.Err(); err != nil {
+		fmt.Fprintf(w, "  privileges... ERROR %v\n", err)
+		return false
+	}
+
+	granted := globalPrivileges(grants)
+	ok := true
+	for _, privilege := range requiredPrivileges {
+		if granted["ALL PRIVILEGES"] || granted[privilege.name] {
+			fmt.Fprintf(w, "  privilege %s... OK\n", privilege.name)
+			continue
+		}
+
+		ok = false
+		fmt.Fprintf(w, "  privilege %s... ERROR missing, needed by %s\n", privilege.name, privilege.reason)
+		fmt.Fprintf(w, "    GRANT %s ON *.* TO %s\n", privilege.name, quoteUser(user))
+	}
+
+	return ok
+}
+
+// globalPrivileges returns the privileges on *.* of the SHOW GRANTS statements
+func globalPrivileges(grants []string) map[string]bool {
+	privileges := map[string]bool{}
+	for _, grant := range grants {
+		if !strings.HasPrefix(grant, "GRANT ") {
+			continue
+		}
+
+		on := strings.Index(grant, " ON ")
+		to := strings.Index(grant, " TO ")
+		if on < 0 || to < on {
+			continue
+		}
+		if strings.Trim(grant[on+len(" ON "):to], " `") != "*.*" {
+			continue
+		}
+
+		for _, privilege := range strings.Split(grant[len("GRANT "):on], ",") {
+			privileges[strings.ToUpper(strings.TrimSpace(privilege))] = true
+		}
+	}
+
+	return privileges
+}
+
+// quoteUser quotes the user@host of CURRENT_USER for a GRANT statement
+func quoteUser(user string) string {
+	i := strings.LastIndex(user, "@")
+	if i < 0 {
+		return "'" + user + "'"
+	}
+
+	return "'" + user[:i] + "'@'" + user[i+1:] + "'"
+}
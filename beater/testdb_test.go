@@ -0,0 +1,34 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+)
+
+func TestGlobalPrivileges(t *testing.T) {
+	granted := globalPrivileges([]string{
+		"GRANT PROCESS, REPLICATION CLIENT ON *.* TO `mysqlbeat`@`%`",
+		"GRANT SELECT ON `performance_schema`.* TO `mysqlbeat`@`%`",
+		"GRANT BACKUP_ADMIN ON *.* TO `mysqlbeat`@`%`",
+	})
+
+	for _, privilege := range []string{"PROCESS", "REPLICATION CLIENT", "BACKUP_ADMIN"} {
+		if !granted[privilege] {
+			t.Errorf("%v isn't granted", privilege)
+		}
+	}
+	if granted["SELECT"] {
+		t.Errorf("SELECT on a database is a global privilege")
+	}
+
+	if granted := globalPrivileges([]string{"GRANT ALL PRIVILEGES ON *.* TO 'root'@'localhost' WITH GRANT OPTION"}); !granted["ALL PRIVILEGES"] {
+		t.Errorf("ALL PRIVILEGES isn't granted")
+	}
+}
+
+func TestQuoteUser(t *testing.T) {
+	if user := quoteUser("mysqlbeat@10.0.%"); user != "'mysqlbeat'@'10.0.%'" {
+		t.Errorf("quoted user: %v", user)
+	}
+}
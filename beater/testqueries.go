@@ -0,0 +1,77 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// TestQueries runs every query and module of the config once, on the hosts
+// and the replica pool, and prints the events to w as pretty JSON instead of
+// publishing them. The events are those of the first collection cycle: the
+// delta columns have no rate yet and the beat processors don't run. The
+// failures are printed as error events, TestQueries returns an error when a
+// query, a module or a connection failed.
+func TestQueries(cfg *common.Config, w io.Writer) error {
+	pipeline := &printPipeline{w: w, pretty: true}
+	b := &beat.Beat{Publisher: pipeline}
+	bt, err := newCommandBeat(cfg, b)
+	if err != nil {
+		return err
+	}
+
+	for _, h := range append(append([]*host{}, bt.hosts...), bt.pool...) {
+		h.client, err = bt.connect(pipeline)
+		if err != nil {
+			return err
+		}
+		defer h.close()
+	}
+
+	failures := countErrors()
+	if err := bt.beat(context.Background(), b); err != nil {
+		return err
+	}
+	if pipeline.err != nil {
+		return pipeline.err
+	}
+
+	if failures = countErrors() - failures; failures > 0 {
+		return fmt.Errorf("%d queries, modules or connections failed", failures)
+	}
+
+	return nil
+}
+
+// countErrors returns the number of errors counted by the metrics
+func countErrors() int64 {
+	var errors int64
+	for _, metric := range metricErrors {
+		errors += metric.Get()
+	}
+
+	return errors
+}
+
+// newCommandBeat creates the beat of a command from the mysqlbeat settings of
+// the config, without running it
+func newCommandBeat(cfg *common.Config, b *beat.Beat) (*Mysqlbeat, error) {
+	sub := common.NewConfig()
+	if cfg.HasField("mysqlbeat") {
+		var err error
+		sub, err = cfg.Child("mysqlbeat", -1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	beater, err := New(b, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	return beater.(*Mysqlbeat), nil
+}
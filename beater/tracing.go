@@ -0,0 +1,249 @@
+package beater
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+const (
+	// defaultTracingEndpoint is the OTLP/HTTP traces endpoint of a local
+	// collector
+	defaultTracingEndpoint = "http://localhost:4318/v1/traces"
+
+	// OTLP span kinds and status codes
+	spanKindInternal = 1
+	spanKindClient   = 3
+	spanStatusError  = 2
+)
+
+// tracer exports a trace of every collection cycle to an OTLP/HTTP endpoint,
+// with JSON encoded requests. The cycle is the root span, the collection of
+// every host and the runs of its queries are child spans.
+type tracer struct {
+	config config.Tracing
+	client *http.Client
+}
+
+// trace is the trace of a collection cycle, it holds the ended spans
+type trace struct {
+	id    string
+	mutex sync.Mutex
+	spans []*span
+}
+
+// span is an operation of a trace, a nil span records nothing
+type span struct {
+	trace      *trace
+	id         string
+	parentID   string
+	name       string
+	kind       int
+	start      time.Time
+	end        time.Time
+	attributes map[string]interface{}
+	err        error
+}
+
+// spanKey is the context key of the current span
+type spanKey struct{}
+
+// newTracer creates the tracer of the tracing settings
+func newTracer(c config.Tracing) *tracer {
+	if c.Endpoint == "" {
+		c.Endpoint = defaultTracingEndpoint
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
+
+	return &tracer{config: c, client: &http.Client{Timeout: c.Timeout}}
+}
+
+// startCycle starts the trace and the root span of a cycle, ctx carries the
+// span to the collection of the hosts
+func (t *tracer) startCycle(ctx context.Context, cycle uint64) (context.Context, *span) {
+	if t == nil {
+		return ctx, nil
+	}
+
+	root := &span{
+		trace:      &trace{id: randomID(16)},
+		id:         randomID(8),
+		name:       "collection cycle",
+		kind:       spanKindInternal,
+		start:      time.Now(),
+		attributes: map[string]interface{}{"mysqlbeat.cycle": int64(cycle)},
+	}
+
+	return context.WithValue(ctx, spanKey{}, root), root
+}
+
+// startSpan starts a child span of the span of ctx, when ctx carries one
+func startSpan(ctx context.Context, name string, kind int) (context.Context, *span) {
+	parent, _ := ctx.Value(spanKey{}).(*span)
+	if parent == nil {
+		return ctx, nil
+	}
+
+	s := &span{
+		trace:      parent.trace,
+		id:         randomID(8),
+		parentID:   parent.id,
+		name:       name,
+		kind:       kind,
+		start:      time.Now(),
+		attributes: map[string]interface{}{},
+	}
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// setAttribute sets an attribute of the span
+func (s *span) setAttribute(key string, value interface{}) {
+	if s != nil {
+		s.attributes[key] = value
+	}
+}
+
+// finish ends the span, with the error of its operation
+func (s *span) finish(err error) {
+	if s == nil {
+		return
+	}
+
+	s.end = time.Now()
+	s.err = err
+
+	s.trace.mutex.Lock()
+	defer s.trace.mutex.Unlock()
+	s.trace.spans = append(s.trace.spans, s)
+}
+
+// export sends the ended spans of a trace in the background, without blocking
+// the cycles on the endpoint
+func (t *tracer) export(root *span) {
+	if t == nil || root == nil {
+		return
+	}
+
+	root.trace.mutex.Lock()
+	spans := root.trace.spans
+	root.trace.mutex.Unlock()
+
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		logp.Err("Trace of the cycle not exported: %v", err)
+		return
+	}
+
+	go func() {
+		req, err := http.NewRequest(http.MethodPost, t.config.Endpoint, bytes.NewReader(body))
+		if err != nil {
+			logp.Err("Trace of the cycle not exported: %v", err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		for name, value := range t.config.Headers {
+			req.Header.Set(name, value)
+		}
+
+		resp, err := t.client.Do(req)
+		if err != nil {
+			logp.Err("Trace of the cycle not exported: %v", err)
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			logp.Err("Trace of the cycle not exported: %s", resp.Status)
+		}
+	}()
+}
+
+// otlpRequest returns the OTLP export request of spans, in the JSON encoding
+// of OTLP/HTTP (hex ids, int64 values as strings)
+func otlpRequest(spans []*span) map[string]interface{} {
+	var encoded []interface{}
+	for _, s := range spans {
+		e := map[string]interface{}{
+			"traceId":           s.trace.id,
+			"spanId":            s.id,
+			"name":              s.name,
+			"kind":              s.kind,
+			"startTimeUnixNano": strconv.FormatInt(s.start.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(s.end.UnixNano(), 10),
+			"attributes":        otlpAttributes(s.attributes),
+		}
+		if s.parentID != "" {
+			e["parentSpanId"] = s.parentID
+		}
+		if s.err != nil {
+			e["status"] = map[string]interface{}{"code": spanStatusError, "message": s.err.Error()}
+		}
+		encoded = append(encoded, e)
+	}
+
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": otlpAttributes(map[string]interface{}{"service.name": "mysqlbeat"}),
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": "mysqlbeat"},
+						"spans": encoded,
+					},
+				},
+			},
+		},
+	}
+}
+
+// otlpAttributes returns the OTLP key values of attributes
+func otlpAttributes(attributes map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var encoded []interface{}
+	for _, key := range keys {
+		var v map[string]interface{}
+		switch value := attributes[key].(type) {
+		case int64:
+			v = map[string]interface{}{"intValue": strconv.FormatInt(value, 10)}
+		case int:
+			v = map[string]interface{}{"intValue": strconv.Itoa(value)}
+		case bool:
+			v = map[string]interface{}{"boolValue": value}
+		case float64:
+			v = map[string]interface{}{"doubleValue": value}
+		default:
+			v = map[string]interface{}{"stringValue": fmt.Sprint(value)}
+		}
+		encoded = append(encoded, map[string]interface{}{"key": key, "value": v})
+	}
+
+	return encoded
+}
+
+// randomID returns a random trace or span id of n bytes, hex encoded
+func randomID(n int) string {
+	id := make([]byte, n)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
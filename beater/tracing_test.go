@@ -0,0 +1,49 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+func TestTracing(t *testing.T) {
+	tr := newTracer(config.Tracing{Enabled: true})
+	ctx, root := tr.startCycle(context.Background(), 7)
+
+	hostCtx, hostSpan := startSpan(ctx, "collect db1:3306", spanKindInternal)
+	_, querySpan := startSpan(hostCtx, "query status", spanKindClient)
+	querySpan.setAttribute("db.statement", "SHOW GLOBAL STATUS")
+	querySpan.finish(errors.New("denied"))
+	hostSpan.finish(nil)
+	root.finish(nil)
+
+	if len(root.trace.spans) != 3 {
+		t.Fatalf("%d spans", len(root.trace.spans))
+	}
+	if querySpan.parentID != hostSpan.id || hostSpan.parentID != root.id || len(root.trace.id) != 32 {
+		t.Errorf("trace ids: root %s/%s, host parent %s, query parent %s", root.trace.id, root.id, hostSpan.parentID, querySpan.parentID)
+	}
+
+	request := otlpRequest(root.trace.spans)
+	spans := request["resourceSpans"].([]interface{})[0].(map[string]interface{})["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+	query := spans[0].(map[string]interface{})
+	if query["name"] != "query status" || query["status"].(map[string]interface{})["message"] != "denied" {
+		t.Errorf("query span: %v", query)
+	}
+	if _, exists := spans[2].(map[string]interface{})["parentSpanId"]; exists {
+		t.Errorf("root span with a parent: %v", spans[2])
+	}
+
+	// Without a trace the spans record nothing
+	_, s := startSpan(context.Background(), "query status", spanKindClient)
+	s.setAttribute("db.rows", 1)
+	s.finish(nil)
+	var disabled *tracer
+	if _, root := disabled.startCycle(context.Background(), 1); root != nil {
+		t.Errorf("disabled tracer: root span")
+	}
+}
@@ -0,0 +1,190 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/anzot/mysqlbeat/config"
+)
+
+const defaultSSHTimeout = 10 * time.Second
+
+var (
+	dialersMutex sync.Mutex
+
+	// dialers maps the dialer settings to the driver network names they are registered with
+	dialers = map[string]string{}
+)
+
+// registerDialer registers a driver network that dials through the dialer
+// created by newDialer, the network is registered once per key. It returns
+// the network name to use in the connection string.
+func registerDialer(key string, newDialer func() (mysql.DialContextFunc, error)) (string, error) {
+	dialersMutex.Lock()
+	defer dialersMutex.Unlock()
+
+	if network, exists := dialers[key]; exists {
+		return network, nil
+	}
+
+	dial, err := newDialer()
+	if err != nil {
+		return "", err
+	}
+
+	network := fmt.Sprintf("mysqlbeat%d", len(dialers))
+	mysql.RegisterDialContext(network, dial)
+	dialers[key] = network
+
+	return network, nil
+}
+
+// sshTunnel dials the MySQL servers through an SSH bastion host, the SSH
+// connection is shared by all the hosts using the same tunnel settings and is
+// established again when it breaks.
+type sshTunnel struct {
+	config       config.SSHTunnel
+	clientConfig *ssh.ClientConfig
+
+	mutex  sync.Mutex
+	client *ssh.Client
+}
+
+// registerSSHTunnel registers the driver network of an SSH tunnel
+func registerSSHTunnel(c config.SSHTunnel) (string, error) {
+	return registerDialer(fmt.Sprintf("ssh:%+v", c), func() (mysql.DialContextFunc, error) {
+		t, err := newSSHTunnel(c)
+		if err != nil {
+			return nil, err
+		}
+
+		return t.dial, nil
+	})
+}
+
+func newSSHTunnel(c config.SSHTunnel) (*sshTunnel, error) {
+	var auths []ssh.AuthMethod
+
+	if c.PrivateKey != "" {
+		key, err := ioutil.ReadFile(c.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the ssh private key: %v", err)
+		}
+
+		var signer ssh.Signer
+		if c.Passphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase(key, []byte(c.Passphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey(key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the ssh private key: %v", err)
+		}
+
+		auths = append(auths, ssh.PublicKeys(signer))
+	}
+
+	if c.Agent {
+		conn, err := net.Dial("unix", os.Getenv("SSH_AUTH_SOCK"))
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to the ssh agent: %v", err)
+		}
+
+		auths = append(auths, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+	}
+
+	if len(auths) == 0 {
+		return nil, fmt.Errorf("ssh tunnel %v requires a private_key or the agent", c.Host)
+	}
+
+	var hostKeyCallback ssh.HostKeyCallback
+	if c.Insecure {
+		hostKeyCallback = ssh.InsecureIgnoreHostKey()
+	} else {
+		if c.KnownHosts == "" {
+			return nil, fmt.Errorf("ssh tunnel %v requires a known_hosts file (or insecure)", c.Host)
+		}
+
+		var err error
+		hostKeyCallback, err = knownhosts.New(c.KnownHosts)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the ssh known_hosts file: %v", err)
+		}
+	}
+
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = defaultSSHTimeout
+	}
+
+	t := &sshTunnel{
+		config: c,
+		clientConfig: &ssh.ClientConfig{
+			User:            c.Username,
+			Auth:            auths,
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         timeout,
+		},
+	}
+	return t, nil
+}
+
+// dial opens a connection to addr through the bastion host
+func (t *sshTunnel) dial(ctx context.Context, addr string) (net.Conn, error) {
+	client, err := t.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := client.Dial("tcp", addr)
+	if err != nil {
+		// The SSH connection may be broken, establish it again on the next dial
+		t.reset(client)
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// connect returns the SSH connection to the bastion host, connecting if needed
+func (t *sshTunnel) connect() (*ssh.Client, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.client != nil {
+		return t.client, nil
+	}
+
+	client, err := ssh.Dial("tcp", t.config.Host, t.clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to the ssh bastion %v: %v", t.config.Host, err)
+	}
+
+	logp.Info("SSH tunnel connected to the bastion %s", t.config.Host)
+	t.client = client
+
+	return client, nil
+}
+
+// reset closes a broken SSH connection
+func (t *sshTunnel) reset(client *ssh.Client) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.client == client {
+		t.client.Close()
+		t.client = nil
+	}
+}
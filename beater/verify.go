@@ -0,0 +1,80 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/elastic/beats/libbeat/logp"
+)
+
+// verifyQueries prepares the queries of the configured hosts and the replica
+// pool against their servers when the beat starts, so that the syntax errors,
+// missing tables and permission problems surface before the first cycle. The
+// queries the server can't prepare (some SHOW statements) aren't verified.
+// The failures stop the beat, or are only logged with warn_only.
+func (bt *Mysqlbeat) verifyQueries(ctx context.Context) error {
+	var failures []string
+	for _, h := range append(append([]*host{}, bt.hosts...), bt.pool...) {
+		for _, err := range verifyHost(ctx, h) {
+			failures = append(failures, err.Error())
+		}
+	}
+	if len(failures) == 0 {
+		logp.Info("Queries verified")
+		return nil
+	}
+
+	if bt.config.VerifyQueries.WarnOnly {
+		for _, failure := range failures {
+			logp.Warn("Query verification: %s", failure)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("query verification failed: %s", strings.Join(failures, "; "))
+}
+
+// verifyHost prepares the queries of a host, it returns their errors
+func verifyHost(ctx context.Context, h *host) []error {
+	if len(h.queries) == 0 {
+		return nil
+	}
+
+	db, err := h.open()
+	if err != nil {
+		return []error{fmt.Errorf("host %s: %v", h, newCollectError(errorKindConnection, err))}
+	}
+
+	var errs []error
+	for i, query := range h.queries {
+		queryDB := db
+		if query.Heavy {
+			queryDB, err = h.openHeavy()
+			if err != nil {
+				return append(errs, fmt.Errorf("host %s: %v", h, newCollectError(errorKindConnection, err)))
+			}
+		}
+
+		stmt, err := queryDB.PrepareContext(ctx, query.SQL)
+		if err != nil {
+			if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == errUnsupportedPS {
+				logp.Debug("mysqlbeat", "Host %s query %v not verified: it can't be prepared", h, queryLabel(i, query))
+				continue
+			}
+
+			err = newCollectError(errorKindQuery, err)
+			if errorKind(err) == errorKindConnection {
+				// The other queries of the host would fail the same way
+				return append(errs, fmt.Errorf("host %s: %v", h, err))
+			}
+			errs = append(errs, fmt.Errorf("host %s query %v: %v", h, queryLabel(i, query), err))
+			continue
+		}
+		stmt.Close()
+	}
+
+	return errs
+}
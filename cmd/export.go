@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/anzot/mysqlbeat/beater"
+
+	"github.com/elastic/beats/libbeat/cfgfile"
+)
+
+// genExportQueriesCmd generates the export queries command, which prints the
+// queries of the config as they run
+func genExportQueriesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "queries",
+		Short: "Export the resolved configuration of the queries",
+		Long: "Print the queries of the config as they run, in JSON: in the order of their dependencies, with " +
+			"the environment variables expanded, the global defaults applied and the hosts running them.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := cfgfile.Load("", nil)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading the config: %v\n", err)
+				os.Exit(1)
+			}
+
+			if err := beater.ExportQueries(cfg, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting the queries: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/anzot/mysqlbeat/beater"
+)
+
+// genEncryptPasswordCmd generates the encrypt-password command, which prints
+// the encryptedpassword value of a password
+func genEncryptPasswordCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "encrypt-password",
+		Short: "Print the encryptedpassword value of a password",
+		Long: "Read a password from the terminal (without echo) or from stdin and print the value of the " +
+			"encryptedpassword setting. The password isn't read from the arguments, which end up in the shell history.",
+		Run: func(cmd *cobra.Command, args []string) {
+			password, err := readPassword()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading the password: %v\n", err)
+				os.Exit(1)
+			}
+
+			encrypted, err := beater.EncryptPassword(password)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error encrypting the password: %v\n", err)
+				os.Exit(1)
+			}
+
+			fmt.Println(encrypted)
+		},
+	}
+}
+
+// readPassword reads a password from the terminal, or the first line of stdin
+// when it isn't a terminal
+func readPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if terminal.IsTerminal(fd) {
+		fmt.Fprint(os.Stderr, "Password: ")
+		password, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		return string(password), err
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -1,10 +1,15 @@
 package cmd
 
 import (
+	"github.com/spf13/cobra"
+
 	"github.com/anzot/mysqlbeat/beater"
 
+	"github.com/elastic/beats/libbeat/asset"
+	"github.com/elastic/beats/libbeat/cfgfile"
 	cmd "github.com/elastic/beats/libbeat/cmd"
 	"github.com/elastic/beats/libbeat/cmd/instance"
+	"github.com/elastic/beats/libbeat/logp"
 )
 
 // Name of this beat
@@ -12,3 +17,43 @@ var Name = "mysqlbeat"
 
 // RootCmd to handle beats cli
 var RootCmd = cmd.GenRootCmdWithSettings(beater.New, instance.Settings{Name: Name})
+
+func init() {
+	RootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		registerQueryFields()
+	}
+	RootCmd.PersistentFlags().StringVar(&beater.DryRun, "dry-run", "", "Write the events as NDJSON to stdout (or to the file given as --dry-run=<path>) instead of the output")
+	RootCmd.PersistentFlags().Lookup("dry-run").NoOptDefVal = "-"
+	RootCmd.AddCommand(genStatusCmd())
+	RootCmd.AddCommand(genEncryptPasswordCmd())
+	RootCmd.TestCmd.AddCommand(genTestQueriesCmd())
+	RootCmd.TestCmd.AddCommand(genTestDBCmd())
+	RootCmd.ExportCmd.AddCommand(genExportQueriesCmd())
+}
+
+// registerQueryFields adds the fields of the configured queries to the fields
+// of the index template (setup, export template and the template loading of
+// the beat). The config errors are reported when the beat loads its config.
+func registerQueryFields() {
+	cfg, err := cfgfile.Load("", nil)
+	if err != nil {
+		return
+	}
+
+	fields, err := beater.QueryFields(cfg)
+	if err != nil {
+		logp.Err("Query fields are not added to the index template: %v", err)
+		return
+	}
+	if fields == nil {
+		return
+	}
+
+	encoded, err := asset.EncodeData(string(fields))
+	if err != nil {
+		logp.Err("Query fields are not added to the index template: %v", err)
+		return
+	}
+
+	asset.SetFields(Name, "queries.yml", asset.BeatFieldsPri, func() string { return encoded })
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/libbeat/cfgfile"
+)
+
+// defaultStatsHost is the default address of the http endpoint of the beat
+const defaultStatsHost = "localhost:5066"
+
+// queryStatus is the status of a query in the stats of a running beat
+type queryStatus struct {
+	LastRun     string `json:"last_run"`
+	LastSuccess string `json:"last_success"`
+	LastFailure string `json:"last_failure"`
+	LastError   string `json:"last_error"`
+}
+
+// genStatusCmd generates the status command, which prints when the queries of
+// a running beat last succeeded and last failed
+func genStatusCmd() *cobra.Command {
+	var host string
+	command := &cobra.Command{
+		Use:   "status",
+		Short: "Show the last success and failure of the queries of the running beat",
+		Long: "Show when every query of every host of the running beat last succeeded and last failed, from the " +
+			"stats of its http endpoint (http.enabled must be set).",
+		Run: func(cmd *cobra.Command, args []string) {
+			if host == "" {
+				host = statsHost()
+			}
+			if err := printStatus(host); err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading the status of the beat at %s: %v\n", host, err)
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVar(&host, "host", "", "Address of the http endpoint of the beat (default: the http.host and http.port settings, or "+defaultStatsHost+")")
+
+	return command
+}
+
+// statsHost returns the address of the http endpoint of the config
+func statsHost() string {
+	settings := struct {
+		Host string `config:"http.host"`
+		Port int    `config:"http.port"`
+	}{Host: "localhost", Port: 5066}
+
+	cfg, err := cfgfile.Load("", nil)
+	if err != nil {
+		return defaultStatsHost
+	}
+	if err := cfg.Unpack(&settings); err != nil {
+		return defaultStatsHost
+	}
+
+	return fmt.Sprintf("%s:%d", settings.Host, settings.Port)
+}
+
+// printStatus prints the status of the queries in the stats of the beat
+func printStatus(host string) error {
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get("http://" + host + "/stats")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("stats endpoint: %s", resp.Status)
+	}
+
+	var stats struct {
+		Mysqlbeat struct {
+			Queries map[string]json.RawMessage `json:"queries"`
+		} `json:"mysqlbeat"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return err
+	}
+
+	// The replica pool hosts are nested under replica_pool
+	hosts := map[string]map[string]queryStatus{}
+	for name, raw := range stats.Mysqlbeat.Queries {
+		if name == "replica_pool" {
+			var pool map[string]map[string]queryStatus
+			if err := json.Unmarshal(raw, &pool); err != nil {
+				return err
+			}
+			for poolHost, queries := range pool {
+				hosts[poolHost+" (replica pool)"] = queries
+			}
+			continue
+		}
+
+		var queries map[string]queryStatus
+		if err := json.Unmarshal(raw, &queries); err != nil {
+			return err
+		}
+		hosts[name] = queries
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "HOST\tQUERY\tLAST SUCCESS\tLAST FAILURE\tLAST ERROR")
+	for _, name := range sortedKeys(hosts) {
+		queries := hosts[name]
+		queryNames := make([]string, 0, len(queries))
+		for query := range queries {
+			queryNames = append(queryNames, query)
+		}
+		sort.Strings(queryNames)
+
+		for _, query := range queryNames {
+			status := queries[query]
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", name, query, orNever(status.LastSuccess), orNever(status.LastFailure), status.LastError)
+		}
+	}
+
+	return w.Flush()
+}
+
+// sortedKeys returns the sorted hosts names
+func sortedKeys(hosts map[string]map[string]queryStatus) []string {
+	names := make([]string, 0, len(hosts))
+	for name := range hosts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// orNever returns the time, or never when it's empty
+func orNever(t string) string {
+	if t == "" {
+		return "never"
+	}
+
+	return t
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/anzot/mysqlbeat/beater"
+
+	"github.com/elastic/beats/libbeat/cfgfile"
+)
+
+// genTestQueriesCmd generates the test queries command, which runs the
+// queries of the config once and prints their events
+func genTestQueriesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "queries",
+		Short: "Run the configured queries once and print their events",
+		Long: "Connect to the configured hosts, run every query and module once and print the resulting events " +
+			"as pretty JSON to stdout, without publishing them.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := cfgfile.Load("", nil)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading the config: %v\n", err)
+				os.Exit(1)
+			}
+
+			if err := beater.TestQueries(cfg, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error testing the queries: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+}
+
+// genTestDBCmd generates the test db command, which checks the connectivity
+// and the privileges of the configured hosts
+func genTestDBCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "db",
+		Short: "Check the connection to the configured hosts",
+		Long: "Check the DNS resolution, the TCP connection, the authentication, the TLS encryption and the " +
+			"privileges of every configured host, and print how to fix the failures.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := cfgfile.Load("", nil)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading the config: %v\n", err)
+				os.Exit(1)
+			}
+
+			if err := beater.TestDB(cfg, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error testing the hosts: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+}
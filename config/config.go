@@ -3,33 +3,267 @@
 
 package config
 
-import "time"
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/autodiscover"
+	"github.com/elastic/beats/libbeat/common"
+)
 
 type Query struct {
-	Type string `config:"type"`
-	SQL  string `config:"sql"`
+	Name            string        `config:"name"`
+	Group           string        `config:"group"`
+	Type            string        `config:"type"`
+	SQL             string        `config:"sql"`
+	DependsOn       []string      `config:"depends_on"`
+	RunOn           string        `config:"run_on"`
+	Timeout         time.Duration `config:"timeout"`
+	Heavy           bool          `config:"heavy"`
+	RateLimit       float64       `config:"rate_limit" validate:"min=0"`
+	RateLimitPolicy string        `config:"rate_limit_policy"`
+	SampleRate      int           `config:"sample_rate" validate:"min=0"`
+	SampleMode      string        `config:"sample_mode"`
+	PartialResults  bool          `config:"partial_results"`
+	Dataset         string        `config:"dataset"`
+
+	// Column values settings, empty settings default to the global ones
+	NullValues      string            `config:"null_values"`
+	NullDefault     *string           `config:"null_default"`
+	Decimals        string            `config:"decimals"`
+	DecimalColumns  map[string]string `config:"decimal_columns"`
+	Booleans        *bool             `config:"booleans"`
+	BooleanColumns  []string          `config:"boolean_columns"`
+	JSON            string            `config:"json"`
+	Binary          string            `config:"binary"`
+	BinaryColumns   map[string]string `config:"binary_columns"`
+	CoerceNumerics  *bool             `config:"coerce_numerics"`
+	StringColumns   []string          `config:"string_columns"`
+	Durations       string            `config:"durations"`
+	DurationColumns []string          `config:"duration_columns"`
+	GeoPointColumns []string          `config:"geo_point_columns"`
+	GeoPoints       []GeoPoint        `config:"geo_points"`
+	IPColumns       []string          `config:"ip_columns"`
+	NonFinite       string            `config:"non_finite"`
+
+	// Event timestamp from a column of the rows
+	TimestampColumn   string `config:"timestamp_column"`
+	TimestampFormat   string `config:"timestamp_format"`
+	TimestampTimezone string `config:"timestamp_timezone"`
+
+	// Event _id hashed from the values of key columns
+	DocumentIDColumns []string `config:"document_id_columns"`
+
+	// Elasticsearch types of the fields, added to the index template
+	FieldTypes map[string]string `config:"field_types"`
+
+	// Event field names of the columns
+	ColumnNames string `config:"column_names"`
+
+	// Elasticsearch ingest pipeline of the events
+	Pipeline string `config:"pipeline"`
+
+	// Bytes of the rows held before they are published
+	PublishBatchBytes int `config:"publish_batch_bytes" validate:"min=0"`
+}
+
+// GeoPoint combines a latitude and a longitude column into a geo_point field
+type GeoPoint struct {
+	Field string `config:"field" validate:"required"`
+	Lat   string `config:"lat" validate:"required"`
+	Lon   string `config:"lon" validate:"required"`
+}
+
+// Host defines a monitored MySQL server, empty settings default to the global ones
+type Host struct {
+	Name              string     `config:"name"`
+	Hostname          string     `config:"hostname"`
+	Port              string     `config:"port"`
+	Username          string     `config:"username"`
+	Password          string     `config:"password"`
+	EncryptedPassword string     `config:"encryptedpassword"`
+	QueryGroups       []string   `config:"query_groups"`
+	IncludeGroups     []string   `config:"include_query_groups"`
+	ExcludeGroups     []string   `config:"exclude_query_groups"`
+	DiscoverReplicas  bool       `config:"discover_replicas"`
+	ReplicaGroups     []string   `config:"replica_query_groups"`
+	SSHTunnel         *SSHTunnel `config:"ssh_tunnel"`
+	ProxyURL          string     `config:"proxy_url"`
+	Timezone          string     `config:"timezone"`
+	Charset           string     `config:"charset"`
+	Collation         string     `config:"collation"`
+}
+
+// SSHTunnel defines a bastion host used to reach a MySQL server. The bastion
+// host key is verified against the known_hosts file unless insecure is set.
+type SSHTunnel struct {
+	Host       string        `config:"host" validate:"required"`
+	Username   string        `config:"username" validate:"required"`
+	PrivateKey string        `config:"private_key"`
+	Passphrase string        `config:"passphrase"`
+	Agent      bool          `config:"agent"`
+	KnownHosts string        `config:"known_hosts"`
+	Insecure   bool          `config:"insecure"`
+	Timeout    time.Duration `config:"timeout"`
+}
+
+// SRV defines a DNS SRV record listing the MySQL servers to monitor, the
+// record is resolved again every refresh period (60s by default)
+type SRV struct {
+	Name    string        `config:"name" validate:"required"`
+	Refresh time.Duration `config:"refresh"`
+}
+
+// HostsFile defines a YAML or JSON file listing the MySQL servers to monitor,
+// the file is loaded again every reload period (10s by default)
+type HostsFile struct {
+	Path         string        `config:"path" validate:"required"`
+	ReloadPeriod time.Duration `config:"reload.period"`
+}
+
+// Shard splits the hosts, or the queries and modules of every host, between
+// several mysqlbeat instances, which collect the shard of their instance number
+type Shard struct {
+	Instance  int    `config:"instance" validate:"min=0"`
+	Instances int    `config:"instances" validate:"min=1"`
+	By        string `config:"by"`
+}
+
+// Diagnostics defines the opt-in HTTP endpoint of the pprof profiles and the
+// expvar variables (localhost:6060 by default)
+type Diagnostics struct {
+	Enabled bool   `config:"enabled"`
+	Host    string `config:"host"`
+}
+
+// CircuitBreaker disables a query of a host for the timeout after failures
+// consecutive failures (0 never disables the queries)
+type CircuitBreaker struct {
+	Failures int           `config:"failures" validate:"min=0"`
+	Timeout  time.Duration `config:"timeout"`
+}
+
+// VerifyQueries prepares the queries against the servers when the beat starts,
+// the failures stop the beat unless warn_only is set
+type VerifyQueries struct {
+	Enabled  bool `config:"enabled"`
+	WarnOnly bool `config:"warn_only"`
+}
+
+// Prometheus defines the optional HTTP endpoint of the beat metrics in the
+// Prometheus exposition format (localhost:9479 by default)
+type Prometheus struct {
+	Enabled bool   `config:"enabled"`
+	Host    string `config:"host"`
+}
+
+// Tracing defines the optional OTLP/HTTP export of the traces of the cycles
+type Tracing struct {
+	Enabled  bool              `config:"enabled"`
+	Endpoint string            `config:"endpoint"`
+	Headers  map[string]string `config:"headers"`
+	Timeout  time.Duration     `config:"timeout"`
 }
 
 type Config struct {
-	Period            time.Duration `config:"period"`
-	Hostname          string        `config:"hostname"`
-	Port              string        `config:"port"`
-	Username          string        `config:"username"`
-	Password          string        `config:"password"`
-	EncryptedPassword string        `config:"encryptedpassword"`
-	Queries           []Query       `config:"queries"`
-	DeltaWildcard     string        `config:"deltawildcard"`
-	DeltaKeyWildcard  string        `config:"deltakeywildcard"`
+	Period                time.Duration        `config:"period"`
+	Hostname              string               `config:"hostname"`
+	Port                  string               `config:"port"`
+	Username              string               `config:"username"`
+	Password              string               `config:"password"`
+	EncryptedPassword     string               `config:"encryptedpassword"`
+	SSHTunnel             *SSHTunnel           `config:"ssh_tunnel"`
+	ProxyURL              string               `config:"proxy_url"`
+	Timezone              string               `config:"timezone"`
+	Charset               string               `config:"charset"`
+	Collation             string               `config:"collation"`
+	MaxOpenConns          int                  `config:"max_open_conns" validate:"min=0"`
+	MaxIdleConns          int                  `config:"max_idle_conns" validate:"min=0"`
+	ConnMaxLifetime       time.Duration        `config:"conn_max_lifetime"`
+	HeavySessionVariables map[string]string    `config:"heavy_session_variables"`
+	Hosts                 []Host               `config:"hosts"`
+	ReplicaPool           []Host               `config:"replica_pool"`
+	SRV                   *SRV                 `config:"srv"`
+	HostsFile             *HostsFile           `config:"hosts_file"`
+	Autodiscover          *autodiscover.Config `config:"autodiscover"`
+	ReplicasRefresh       time.Duration        `config:"replicas_refresh"`
+	QueryGroups           []string             `config:"query_groups"`
+	Queries               []Query              `config:"queries"`
+	NullValues            string               `config:"null_values"`
+	NullDefault           string               `config:"null_default"`
+	Decimals              string               `config:"decimals"`
+	Booleans              bool                 `config:"booleans"`
+	JSON                  string               `config:"json"`
+	Binary                string               `config:"binary"`
+	CoerceNumerics        bool                 `config:"coerce_numerics"`
+	Durations             string               `config:"durations"`
+	NonFinite             string               `config:"non_finite"`
+	ColumnNames           string               `config:"column_names"`
+	SanitizeColumnNames   bool                 `config:"sanitize_column_names"`
+	ExpandDots            bool                 `config:"expand_dots"`
+	PrepareQueries        bool                 `config:"prepare_queries"`
+	PublishBatchSize      int                  `config:"publish_batch_size" validate:"min=1"`
+	PublishBatchBytes     int                  `config:"publish_batch_bytes" validate:"min=1"`
+	QueryTimeout          time.Duration        `config:"query_timeout"`
+	KillTimedOutQueries   bool                 `config:"kill_timed_out_queries"`
+	SlowQueryThreshold    float64              `config:"slow_query_threshold" validate:"min=0"`
+	LatencyLogPeriod      time.Duration        `config:"latency_log_period"`
+	QueryRetries          int                  `config:"query_retries" validate:"min=0"`
+	QueryRetryBackoff     time.Duration        `config:"query_retry_backoff"`
+	VerifyQueries         VerifyQueries        `config:"verify_queries"`
+	RateLimit             float64              `config:"rate_limit" validate:"min=0"`
+	RateLimitPolicy       string               `config:"rate_limit_policy"`
+	MaxPendingEvents      int                  `config:"max_pending_events" validate:"min=0"`
+	ErrorEvents           bool                 `config:"error_events"`
+	HeartbeatEvents       bool                 `config:"heartbeat_events"`
+	CircuitBreaker        CircuitBreaker       `config:"circuit_breaker"`
+	Shard                 *Shard               `config:"shard"`
+	Diagnostics           *Diagnostics         `config:"diagnostics"`
+	Prometheus            *Prometheus          `config:"prometheus"`
+	Tracing               *Tracing             `config:"tracing"`
+	Modules               []*common.Config     `config:"modules"`
+	ECS                   bool                 `config:"ecs"`
+	HostMetadata          bool                 `config:"host_metadata"`
+	CloudMetadata         bool                 `config:"cloud_metadata"`
+	EventMetadata         common.EventMetadata `config:",inline"`
+	DeltaWildcard         string               `config:"deltawildcard"`
+	DeltaKeyWildcard      string               `config:"deltakeywildcard"`
 }
 
 var DefaultConfig = Config{
-	Period:            1 * time.Second,
-	Hostname:          "",
-	Port:              "",
-	Username:          "",
-	Password:          "",
-	EncryptedPassword: "",
-	Queries:           []Query{},
-	DeltaWildcard:     "",
-	DeltaKeyWildcard:  "",
+	Period:             1 * time.Second,
+	Hostname:           "",
+	Port:               "",
+	Username:           "",
+	Password:           "",
+	EncryptedPassword:  "",
+	Timezone:           "UTC",
+	Charset:            "utf8mb4",
+	MaxIdleConns:       2,
+	Hosts:              []Host{},
+	QueryGroups:        []string{},
+	Queries:            []Query{},
+	NullValues:         "empty",
+	Decimals:           "float",
+	JSON:               "object",
+	Binary:             "string",
+	CoerceNumerics:     true,
+	Durations:          "string",
+	NonFinite:          "drop",
+	ColumnNames:        "original",
+	ExpandDots:         true,
+	PublishBatchSize:   1000,
+	PublishBatchBytes:  10 * 1024 * 1024,
+	RateLimitPolicy:    "drop",
+	QueryRetries:       2,
+	QueryRetryBackoff:  100 * time.Millisecond,
+	SlowQueryThreshold: 80,
+	LatencyLogPeriod:   10 * time.Minute,
+	ErrorEvents:        true,
+	CircuitBreaker: CircuitBreaker{
+		Timeout: 5 * time.Minute,
+	},
+	HostMetadata:     true,
+	CloudMetadata:    true,
+	DeltaWildcard:    "",
+	DeltaKeyWildcard: "",
 }
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvflzGznSKPh7/xVYTcTKnkdRh+Wj9WLet2rL3a0dHxpL/vrNvH5hglUgiVYVUA2gRLM39n/fQGYChTqowxY97v00MzEWySogkUgk8s6/sF+O3789ffvT/8FONFPaMZFLx9xCWjaThWC5NCJzxWrEpGNLbtlcKGG4EzmbrphbCPbq5TmrjP5NZG703V/YlFuRM63g+ythrNSK7Y/3x3vj7/7CzgrBrWBX0krHFs5V9mh3dy7dop6OM13uioJbJ7NdkVnmNLP1fC6sY9mCq7mAr/ywMymK3I6/+26HXYrVEROZ/Y4xJ10hjvwD3zGWC5sZWTmpFXzFfqR3GL199B1jO0zxUhyx7f/LyVJYx8tq+zvGGCvElSiOWKaNgM9G/F5LI/Ij5kyNX7lVJY5Yzh1+bM23fcKd2PVjsuVCKECTuBLKMW3kXCqPvvF38B5jFx7X0sJDeXxPfHKGZx7NM6PLZoSRn1hmvChWzIjKCCuUk2oOE9GIzXSDG2Z1bTIR5z+dJS/gb2zBLVM6QFuwiJ4RksYVL2oBQEdgKl3VhZ+GhqXJZtJYB+93wDIiE/KqgaqSlSikauB6TzjH/WIzbRgvChzBjnGfxCdeVn7Ttw/29p/t7D3dOXhysffiaO/p0ZPD8YunT/61nWxzwaeisIMbjLupp56K4Qv88yN+fylWS23ygY1+WVunS//ALuKk4tLYuIaXXLGpYLU/Ek4znuesFI4zqWbalNwP4r+nNbHzha6LHI5hppXjUjElrN86BAfI1//nuChwDyzjRjDrtEcUtwHSCMCrgKBJrrNLYSaMq5xNLl/YCaGjg0l6j1dVITOOq5xpvTPlhn4S6urIH/i8zvzPCX5LYS2fi2sQ7MQnN4DFH7VhhZ4THoAcaCzafMIG/uSfpJ9HTFdOlvKPSHaeTK6kWPojIRXj8LT/QpiIFD+ddabOXO3RVui5ZUvpFrp2jKuG6lswjJh2C2GIe7AMdzbTKuNOqITwnfZAlIyzRV1ytWMEz/m0EMzWZcnNiunkwKWnsKwLJ6sirt0y8Ulaf+IXYtVMWE6lEjmTymmmVXy6eyJ+FkWh2S/aFHmyRY7PrzsAKaHLudJGfORTfSWO2P7ewWF/515L6/x66D0bKd3xORM8W4RVtg/r/9pq6GdrxLaEujrY+t/pUeVzoZBSiKsfxy/mRtfVETsYoKOLhcA34y7RKSLeyhmf+k1GLjhzS394PP90/n6bBdpXK49z7g9hUfhjN2K5cPiHNkxPrTBXfnuQXLUns4X2O6UNc/xSWFYKbmsjSv8ADRsf6x5Oy6TKijoX7AfBPRuAtVpW8hXjhdXM1Mq/TfMaO4YLDRY6/istlYa0C88jp6Jhx0DZHn4uCxtoD5FkaqX8OdGIIA9bsr5w3pcLYVLmveBVJTwF+sXCSY1LBcbuEaCIGmdaO6Wd3/Ow2CN2itNlXhDQM1w0nFt/EEcNfGNPCowEkangbpyc3+OzNyCS0MXZXhDtOK+qXb8UmYkxa2gjZb65FgF1wHVBzmByhtQiLfPXK3MLo+v5gv1ei9qPb1fWidKyQl4K9nc+u+Qj9l7kEumjMjoT1ko1D5tCj9s6W3gm/VrPreN2wXAd7BzQTSjDgwhEjiiM0kpzOkS1EKUwvPgoA9eh8yw+OaHyhhf1TvXac909S6/CHEzm/ojMpDBIPtISIh/JGXAgYFP2caTrINP4m8yUIB0EAY5nRlt/+VvHjT9P09qxCW63zCewH34nCBkJ03jBD2dP9/ZmLUR0lx/Z2Rct/YOSv3vx5u7rjtetJ1EkbHhvCff6VDAgY5mvXV7eWp7//00skKQWOF8pR+jtoGUcn0J2iFfQXF4JEFu4otfwafp5IYpqVhf+EPlDTSuMA7ulZj/SgWZSWcdVRmJMhx9ZPzEwJU8kdJ2y5joVFTecRBBavmVKiBz1j+VCZov+VPFkZ7r0k3nxOln36cwLvoHzwFKRJYWv9MwJxQoxc0yUlVv1t3KmdWsX/UZtYhcvVtU12xe4nZ+AWcdXlvFi6f+JuPWioF0E0sRtJWkc3/W3+bhBjYo8O2K1eRZJnKaYiuYRuMLkrLXxzY51CaC1+SXPFl4l6KM4HSfgmZTNDaD6P0mNbSO7A9Oz8d54b8dkB6kYY1syTO200qWuLTuHK+EGeeZYMd68grcIe3R8/hgPJkknBFimlRKgMJ4qJ4wSjp0Z7XSmC4L00enZY2Z0DepiZcRMfhKW1SoXeJF7Ycnowg/muZs2rNRGMCXcUptLpiuvRmrjBZ6g44kFL2b+Bc78fVcIxvNSKmmdP5lXQbjyY+W6REmMO0ZqKy6iLLUasawQ3BSriP0ZCLkRWl3IbAWC5UJ40RcWOL71hanqchoFmuuuykLHW7u1FXQl4DheD9UZCFcEUW+bSN6IX0eCp12kgR4dn799zGoYvFg1N45F4TmiHs/EaWvdCentP91/9n1rwdrMuZJ/AHsc96+RexMT3iXzwNQ92H7S2tPF69cvk3ORFbIj379svrlGwD+mN/0BCDTCLRGFdNLTJ5JjQB0dCw/eTEcVFgV3I+bc5CDQeXlNKztKnkdhbirRAia11whnhV4yIzKv67TUyYuXZzQq3hYNmD3Y/Bf+8QQyOBRWqCjG+2fO//mWVTy7FO6RfTyGWVADrehY96ZCS48Xt1qTBv3DgBlLWA8HScgBS85wZTkAM2bnuhRRZq0tyv5OmJJtBfOVNluNtmvELHAQAkV1FmjxONDPpJvhzk5F1E1AN0sQQEfFg6XmYZubKVL4UcskIgoT+BultrVHCI3aKEVSefB+qxVuAOhIqPUE4+LAYA1+lXa9Ib2wg/u1A6csWHWiLQjH2w3zROsdHB4Un3ieMytKrpzMgB+LT44kLfEJZegRCjbhlNoobznNrqRfrvxDNAqvX6gwoARb6WpO23E6YytdmzjHjBdFIL7ApT2Hm2uzGvlHg6BgnSwKJpRX+Yhu0WTohYlcWOfJw6PUI2wmiyIyGV5VRldGcieK1R2UHZ7nRli7KT0HqB01W6ItmpBkkshmyqmc17q2xQqpGd6JfH3p0WJ1KcBUygppwZZ0ejZiPNx92jDumf0nZrWnkzFj/2wwS6IT2PIaaXkhmOHLAFOg+8mYvpggytqSn/KKcSPY5TXa8vC6moxlNfGgTMYI1mTEclEJlZPojXKzVg0QoGbTjjWSzfi/3KXK7fgbvVcbGKcrJ+wNInCyH2gJab/WAuQH/wNaQaIjgs4JbROysz76Xhy2AENi24BwTnwVxx+35pwLPc6kW33ckCL90su2g7vzxsvSghd9cLRyUgnlNgXT20Spj5P14HurjVuw41IYmfEBIGvlzOqjtPpjpvONoA6nYKfn75ifogfhy+O1YG1qNwmkwQ19yRXP+5gClnWz0jkX+mOlZbwv2kZ0rebS1TneoQV38KEHwfb/w7YKrbaO2M7zJ+Nn+4cvnuyN2FbB3dYRO3w6frr39Pv9F+z/3e4BuUE+tf3BCrMT7sjkJ5TCA3pGjGwFKBnpGZsbruqCG+lW6WW3Ypm/dEEUTC61l+Eui5YYpHBpUMrJhOfiJBDPCq0NXQYjsDwsZCNuNrcGglewarGy0v8RPAFZONY2AeGtdom3E/wcEvXzEi6tudBhtX17xVRbp9VOnvX2xoi51GqTJ+09zHDdQdv5x8t1cG3oqBFMgyftH7WYijaiZHUDDPGBNnGenkXBKXBEuCxSykKjZTB4BBfc6dnVof/i9OzqWSMQdmSgkmcbwM2b45froGYt27Abd/EyeKzX4ObCq3youZye+YlIjsf4jbfHF1EpZo/EeD4mqwsvUuWdoQYYDDItF0A8K4ke6BVNMNOpOSs0z9mUF1xlcHRn0oilV0NA7za69ie6g3G/6EobdzehMwg51hk5LImm2PDj/1nwgfrmHeS91qrP8O3Pku4O2nD09uQ2Quf6/TijPVhH/LUVZjwkUd7fxZbKUWgC0gYNK35ytMCWAhQOPUv2+cfG5zHyGuDrk+MzcPRlYBA9iUORUgg8cLu/OlFyWWxocf7SZjBB4DQD6J3VRTHA/+8ViG3L/DQwLVzV/IrLgk+L/rVwXEyFceyVVNYJ2vYWvGBFGG/MIdp3Cs7IAQ4TR78FqKK7VcGdJ/MBvCKcG0RsSrk4WR+IBbeLjYmEiCk4Jn4ez0oybYzw/LXlfZ+hRQTOk2JcabVKY3mQUyRn64MV5FmcwCpkjpYM+OBXN4kRH5lWM9wrXrTm9DJ2xlVjwWMhQmvoFG7EwfyuI2zUXdKKFz/A0IdqQ1LZ+cKzXRSvIRpDqj4gyZHkcCRbZn1d45TRqh++WG/Ux8BMhuQRjT8wFANL9czwGK3VxKGgdQ6duOFeAVcuWxt3MmNvhDMyQ3+wTf3NXLFXLw/Q2+wpZCZcthAWtItkdCadpVCfBkhPXe0ItVaokbTRj9kGgcY1taIYIiNK7aLXk+naWZmLZKYuZAgTZxTkEhYUNl01r5Jm1A6mw0GbgSCahyYPd78fVtoGVELYXey3Gejtm+PM2xcNgnAuiGJKLWgyj5FpdMpWLJezmTCp5Ab6n4R4LH+5++O544TiyjGhrqTRqmwrDw1tHf9yHieX+ShY54D+2bv3P7HTHGPHwIPTO/B9jfHZs2fPnz9/8eLF9993jJB4Q8pCutXHPxoz7X1j9TiZh/l5PFbQNgw0DUelOUQ95lDbHcGt29nvqHLk8N8cOZyGQI/Tk8C9ANZwCLuAyp39gyeHT589f/H9Hp9muZjtDUO8wSs7wpyG5PShThRP+LIfWXJvEL0JfCAJMrkWje5gXIpc1mVbMTD6Sua38hJ8sbETzlqYcBwOZxonzZd2xPgftREjNs+qUTzI2rBczqXjhc4EV/2bbmlby0LryIYWRcaRzzxu6XWMjJ6wH67k1pfX+Nrjg21/Knk6e2HsSWRtJTI5k8E2EqFAdyG5xEm71rN0kCQnQlgR5l2IokoESLivUCuPQ1u6CdXKI8jJqFLd5oLaiIxHQnCzeJm3z7As+XyjPCU9GzBZdAkgQEtu2bSWhfPX+QBojs83BFlDWQQXn7cBSBI1rp89Sdi4JmWjy2xhUsp+aM27wd1o1twYPSM3QZLdFDvB0VnJFZ976Q34SaSDHifBRJGEjSRe/ZSRnHS+voaVJI9eH/2B0nPyNHgR0Mq1206YGBgzCfi4KdQDuQ+FenyLsQitUIpbBSQ0YizmWN1TQEIcFgITHgISHgISvr2AhPSwBLs1JTl2cfi1ohJS9vQQmvAQmnA/ID2EJtweZw+hCQ+hCX+m0ITkEvuzxSe0QGebCVKQlZ8tvelv8MyLlku+MvKKO8FO3vzr8ZBTHk4N6AbfVFwCOMITewmtFKwoDW6cZtMVYOJEQLbr/a9wE5EGdxDbvl64wVpafog5eIg5eIg5eIg5eIg5+KZiDnLVyrE9eXsOH6+xRv7YskBKNfcvsd9rYaSwsFdc2aVIyvj43ynogKxYQoIjN+ZwNQmwYayVFzn8adVsLhymsOGwNOijSa4suPCO4PnJY6qosQqTpKMDywo5YEhQTW0TGhGnjQZVy5aiKPy/vChi7jLCgL6YpTAieMxy4i3S4jh9KPHVyeO72EtbK753S/72sWLcGL4KyEAs0/tYfoBnCwKDWUq3NMLVRiVHPhTGoljHRniCgAipPAyEssaKGfYGt8CKUKOpZaSdrtirl+dNDv17zB3FsRb8SmCOdcosymY5+GOYXLGlf+vVy3MavqsD+m325Ad6J0pSWMIAfmkb2v1zgczZsWOlVLKsyxF9GccNiypr61rldCZ+lokHDsJaesvwwkq4WEes5FWj3PrRsgX4/lwo6cYtq7S1cooiTA6pkFyt/L8yZN/iwQ3W2GFAuWUZlrdoWfc7FDnOCr4xOz7Go3DUj+KGBI9LjhQjoQoKSvWYUdzjdadvB0FPYpI2EkoD0CbcEUz+olM1jg6H4BgQFCwZ+GolVG6DdAIRBMCwAkrSAcPae3aJ/b1x+N8gFjZpOQIsNKKyp7jEFd8BnVWYX2vbVUQ4yxYcL7OXb4/fvPIHYio8svz7xZXIRylz2t62bILiRMNiXOLV0SpUYfFija20RzGoc81hgEHgXI7ZaeRVSjtmZVkVq96YodLZBPLCgwth4m8eAUUKe9uyXC7Hc7D0jzNdDu6Mc7fRIdapih734K8ELf4KJCnPuWG9gIDBTfBccypYxrNFytjFDPhSy/skbcZNLvIx+5cwOsSHeFIO49MZSPA3bZCGUwx4FobpdIMxOheLJj7nM1kMkGYL7oXguTAfZ0WoFLeB83UMd7aesQNWCOeEAS6JMzOYuRVkV2FdkyaQ54gdH4/YxcsRe38yYu+PR+z4ZMRenozYybseydLHHfb+pPmzbcHfmALnd8gvDa0nqSLHrZVzlZS/NHpueIkUGEt2RiT4R0AsQ5djMhD48ivZeCmROdi+NvvsYH9/v7VuXQ1Ydu998Vg4xssEfjISozBGSGAw0KVUuScHFGBbMi2L9Q2xyFSsPGqFC7hrqlKgaR+HQRkZMAO1EtMx1+LoHx9evf9nC0eRM341iUHPQokRujBQNblRPmjx8E1ejXAndkBLr77oCenEGyutdiojlYP6XdmCQ4VbY9mjqSj0kj05gIgEDwHbP3j2eJSQv7atNxp2HpUkLAUjbMYrf6y4FWx/D26ROczx68nJyeNGEv+BZ5fMFtwuSOn7vdbgWY4j01BjdsGndsQybozkc0Hqg0UxtZBJXMJMiDwdIdPqShiy0P7qRuxXg2/9qoAEBdjnioEaYtdcs3GbjZhL64QR+cfNmiX9ni/kfCGsY82kJCGNwK5aeZyTaGfrafB4R8y0LJTIpTrjgLa2NdM6WfeWP+hbyedugTrkBlR4LhdOmBKuv8qITFpRrFBC4hj+AuUagdnW00JmzNazmfwUR4RnHi2cq452d/ERfGKszfzxmF2YFYjDGkuZfJIldwKv2ekqSFiOXzZGZuTbBbcOqp9hyBlG5nihAqI+QEf3a794fdKUiNzK9Li+3OoTxk1E8ZXEDZK6rudPx8fH7Xs2SL4fv8QndNxT+IuCnZ75G0FA1OwkVZQmHY0l/DgJhgOiHTmbyawuQB+trRixqch4baNR84obKdwqiFrNkQeF13oR0w9FYI3ZK6zf3cCXRHEFQB1WVtUMbCwJcibN5QfVZKWLyjGml+bik3+79KSSDo3cBV+C3wW3XkhwOo7Y1AhCpuevypnuZyBEWamriLW/2+9uMNyrX0OsCHMNu47fvnv1/v279y3oNng2ttPDEc2FLOMV1JgeEaL99Qb0174woRRTExGdmBu1KlZgwrFQhCkxVLaqMsFjmRGhGj3Ap5oKxTOErWtxvC0UDQDBfEjGxRYQnfmhQipgoRKG1v9IV2jLKVZ+CKu1CoW9SPbD0/F4zI5VDplNXvGLYxJW22d/vdkzWAe9VEg8ocdQoxkpFtfNWgZlbCdwnUH5jXB8JzV9hQB4sm3dvkzhTRUsB9oQfFmN36RFA9xjEb9+MZY5PWYTkdkxPTRBF2QAo2GCIOcB66mtw7q44F0pelXQGPtlIRTuGWwgFgSOfgmpcpkJy3Z2yORC5lAoqe40s4WcL1wxlL6VrAbepyYWHrRCeBbtRUFD1dZ4/psHNbids4UoeQf/rFWpfYB09sd7472UcozRrVyLV/GL64uWN7kOGVS4DaZlGNAi+a5AS4p4/IB1+Uo0cuNzZFSuKgFBs4XAZEGP5sAIwOmVcX8Lxbre36VnSzorilkjs3OFo9/B6L+hYCFAJqqQHeMkAnitRn+fOR0D7tgBCNJmCOvBiA0RBhcbVN+Uxq46hTNfXd2yMD7u71AuTygCOJzOU+ggzmLaj5FZi1YiSR5Dr4V2ZU5PPl2endSih/j0Be4jb0rLh9v8ddMTAhhLqJPvgo2Qu2iYBUlczZsxmvL6epYsgsYLQ/FQrp1BRfKQDkxJvk0dUbLgoMAbw1hozOBtAd0gjSAZYYj9QDHSqXBLLwbyWHWQ7ruk8j5ORnU8seB+Vmjr13YcduJmdGPoGA2JFX1rDK4tYESs8ggf064FANAwopPHaNim7n8L6ym1NCgvRanBPSosVJGk4fIE8Q3BXdWFEgbzUGXTWIEethlXfunQVuEuKcm3CIz9bDEQR4+yX7BStdNXSIGNoZ9UsjXxnyVtg8CaLy3uXiNdLLhiE3wg1OqcNAaOuBH+rE8AITs8zycjNiGS3wGSF/DVTBZiByW4fIJGxmBqiyPGav6JdxOzy6oCqGEokbm2wuxU3FqPzB30X7evCwJ9E9vxiqRwnKGL/HjJLeR8QUVbh3kgcMggSXd2pdHVdKgR29kcJIjJKOypFcqSHbQJ2+URzAhXM3KQjngop/sLN/5wQzONWQ2VEaLoo2deFBqxpWBVwRVGP4KPn/G2scMLFlkmKjTIkX09hgFQ25sKW3Z5XRiMKRmvhyOJYachy6xhDetlgvtTvU7pPs4SI3NcBDXNanVsSOggybgKDnO/0MBEc+w5FmsGxM48tUrSr0ZUSbpoUsMYsj/sH1RwNa/9H9owvzyQe0H+RE6rr7yKLkvhtZ6Az+hTTSjME88vUuV6afHeZ6cn/X04fHb4oo18PNY3HLC8Ud7a+CUOg4P0Cl0M9znzFwK0/oqwG8GBYYSmEVhde4VaZ6/5F51QlN49n5T+Ts0oeLRp1xaLFSdfubTSlmvsoqy5zga6q0VfaJdPnypWauuS8skjCvhwS910RiO73lQMqCjIT8PHLPUltvqDZbzIIGuRIlELcGqioJBq5+QfomgXJPE4Zuvehm2BV0NfJGNdEHlEzmSneUeApNRKNqXDWTLE9jaoEWHH/MdQJcJpdilExeoKOQW8lB6uNlahmQRA2sajv6/wxGW8GKU721jWB2Lncu64FTfFBX95XC5O03H2q3b/PLAeg2ehxKRJrtCBTxYiLyhrEwQjjFr3nDjhH4Wej1Cv8H8+HqWT+xMRdgrFgVWTJZmcwkyXSVJJt9MJbKURmS5L4MTQZkVpF/V7GN6LCK25wbMTAw9KnddJdxcMqp3potBLFBA4yzWWy1G9YQasMRXPFmKc4CJub21uk840EPfdeVOqqnYfw4+KK03RBUHorF36ALdvZFHIwWfQzQA0sj9IOCc0dUtuYBBWEqdtUxJyH8S6P8n4WXjlwAh2qfRSpS0YW7EiQxwmsA+YXaGRhvZU9oLAhbqNI3zdRdGA2rsjutcD0pu/DsP3XrK5SjOu/A0CnhNqR9Ypn7DBYOKfuV2wR5UwC15ZaEoGzbpmUs2FAf/lY3CB8CXdT077DeBonW/sr6LUChqhYMtCND9JtxrIbgj1Z4b+Ov7h5clXs22cnvjVxOT8RG/pwDzYr+pS3oqAPluzCnECa9UpNFT3ZfglydrdgiMtXok021ykoSUk6fyJUfcalaCjdsG3k2bMiXXcCa9w8YKbcvJtSvIAZNualbL5jd2tOEsSSnhdmy6QLkhOAUkIBBxbV5U21EQ008rjBGRxGBpFl6KeA3PSQRCKwzb+Ek79sOhCxyv6GG4nYAmPR0G7w5Fj2N6QzNmkhYIS759fd/W1sB5k0k3g/T1fgvUxail6BlmmJpLyB5IwrmFka6R1L0SAk1LghZPr7GNShimX1pNpDgo05kWA3Cy4yRYib06LF0hk7DtnhDNSXAWhffIR92bSR+W5qNj+92zvxdHBs6P9PSye9PLVj0d7/+df9g8O//u5yGq/APzE3MLrNqi5Gvxuf0yP7u/RHw1b0KZktgYJZVZ7NcM6XVUiDy/gv9Zkf9vfG/v/7rPcur8djPfHB+MDW7m/7R88aSe06dp5WW2TvJOmWMc+W12gG6uU19YytGQ2nMS2L/jWyElvt9BPqLEI4oPEGgmF1JF4xmVRGzHIEOOIt2KMt2eIcdzbM8a6L5huuMTZ9nn0yA7tG5oBIBcU+V6IIDlfWdIy+laD13qeaMmlP/a6zbEaN3BQbcJhHUh1b3rpXhO9iJSFfPR8ZaHp28K5Kn+MhRGhh1w9pcopNDCFDsaWq3HER5fCKFGM2BuZGe3n36El7oTDvXNc59K/+7i/j/h2axuNtJcfbcJb13HbWaH5oM/mvbSXDEbALrBSG+na7Z5p/ZZAZFYXQGk2CUz7YAUp+7BkULfJNIEy/0KYbgGpCPtHpU15C0pcu4jtt2DklX+IHIa9YUGjaIcHi1VcxJ4/kvt7ewMdRUsuFaYjU17dStdw9NqqMhECUBQGy9oEINu2d/ghlhyrllvhmYBqloFYI0czL4rQ26yj/Fjxe52oTveXw31OA4dyQGsFWBFhCI+Cu50ay5NJAZRq2zNbjsBqwy/bAf7iE88c0yYXhtI0SMJJ7JdkvSySfP7G4hI13B6yrkRSIONesrDPacyOUyRSf5izI3H/QjH7jeIVzG7NG2mMv+dhVxAxEp8LSrKnQnCOe2a33YiFdRVbTzaujohwcGLRVFKEusTKSuvAsYeEF+IgOpxo+3kHsV43/2IlHDX8G9Vw8v+kinjr9vYKeWPKXaOJe2LZYB3a7US0TJLvmvbmrSVtb9uEepPu3oyEUnIgE8xtVbEwgucr4tG5mPG6cOEebeyTCatGE1qIacLivktpUzvncSOExElDzCBkMnBPkFqB//X0hCbfelUbXYnd49I6YXJebiXB0Hw6NeIKXcLh8fOLrccYXcZ+/vmoLBvilrwIT+3sPT3a29t63DnL/bC4e9IwBJILiF2k2tYYzxDXcoaSF7/SUKI5lifE/fYvQqa2VwYB6gDzTJI2SlEQP4bP1zYP9W91PeaQSNCzCkAwgmVTzxXa7hNy6vtfwZsUXNF+bKoKFxuD+ulCXiKJTtxancmmMT+oJqFzaKudJQZz7nrcyaIVpEMW4xGFxVdG53WGFwNMeRoUNPamUY//14+nb/43PQuRQDQiFfmGFqMQMoQSfhCn++UZ+WyG+TiAzc56AtVEFhNjRu5WMRy8E1/ABrdfQ9C1LFFYBVA9IwtDtzNnSXBVlEPbbKVFh4YzPLsMKoW1Q6bTQR/b3UAG9MM4QIN+jttC2dRmbL/fgfGWVUbvglTunJHT2qFppRSOYyYa+PmH0Yy/xTxeGIasaehDqyu4rCaln2pCDip/8/rbdQKrmCRWOvS6oUPVH2oqmAOJm7IUI2alF6loOJCpVAN3kCY8GF2PEhTT2dC9hpV61tREjgD1FNBOobdYJWZTUMbSMTFcMHJRqo7Zg3F3oUuxy4uAu+hd8ED141vvDVY4P3GSHlgVSZ2xlNjGsv7OjCy5WVGRFn+p/3R68vjafd3e39vb75THizxy0xCmqvwgdP29XHC7GJf50w3B9+bkKU7Rn9Qu+P6GZj3/+Xj/mmkPnj7b3MQHT59dM/VTKoC1kamf7h8MTC3V5kJ2Tv3YTZxziONFxqLi30Gc6p6Vg6fPnrx40ql1tzlo33hgk+PhQdSZ40WnhXcf0L1nh3sdML/wCh64gePVycG3IGeyq6F9pbpPhBuvYcXI7MCNR9Gb1qpt1kMZ/THuMmu9VBuzsKKY7ifYhrAKM1j7sc8DK+425YL+sS4KGD8Vkq67aHfXIc7KP+5o0RoQSv0gnuqhKHMi071TxYoZUYgr7gnQa+IQSAo5RiBpbfmPA2mM+8+edCoxO27mwn3cIFIvYAZEq9cs7aospLrs1KHbYJIY4BK80I88Wkb+HIAySZA87u1w1PxiKa6NlioAXdvLKx9AXjGNoTrJeXh03hFm8OysF2mS2q2oAqLK/hN9vEZj/0noNDEm48as0uZavPHKhwK3aR8xHiTNtqkVIwWamrgt1T/mEhsZPY1OZAsIj2i8Kx6y07MkTh1j0syOrSuvp+R3yZf5dsqAf/MlwL/B8t/fWOnvb77s90PJ72+z5Pe3WO77Gyj13VfHw/0Vv1h/g13EUq1J3l0pyFPZJHrCM5TA6R8JMlVYou5G4t3mXvmmy9J+7Vq0vbhR2sWfw+cbsicXGAJKrUrDvjUuRPidF3NtpFuUMXtOGvI9Jk4BUeR4nin5siy1gvdFCAV/c/J0BNaIx0ANlRHE08bsOM8DGLNow8eukjTEdMUKvRQm4zaoYW3gkGV5ANHhUqtcGHTzW1Fxw52OJTu5xWInlZHcCfbIKn6JPtIRw1CGBX/y8en+wV2qgn5tu9HXNxn9e6xFX9NQFM+Ttq105J/D52sdcaGbYcsRh3FDhT8RVe0w9ZVab4bD8+rlOeZ6/jUcgkGXsHSLAccVTKqbrortxPeQNwwKGYj9gwmvaaqrXytgNOa20ogLbvIlN2LErqRxNS9C10w7YifQXi1pXYg1W/5eT6FngbBM6VzcqSmZyRbSiSwJlbvXytGdGKzWfL1789OLZx+ftTX7h1ZHD62O7g7SbfWdh1ZHD3rPQ6ujr9HqyN+fG4Jk+2caO2013cpVbIoPxKi2ZSjWOwmQTUCa9ueXajQGVaTVuXr7Wi3pftZDKhLKOWkYxLGNeAyZEthnkzoyjCAIkeIVoz5IdbYhYJbyea/tSE8VRWsDukkd8jsmU8EdlnjuYuHz2liBBCSr4Y4um2k/9TNt5fCcm6LPt9fSZlL5D6kyociEEj9Ap1UM2SEmCfkjv9e8ALddHDMpPh5KyHgAQtXcWHkDWmRQ5LDX4lguMplDcScvuwIZNYwdKht2Nl7b8YyXsthUAMm7c4bjs0fBdm5EvuBuxHIxlVyN2MwIMbX5iC0xgr/vBsEne3DXxaaaFfVkXtyJtnMzVE4LVamGRVCeeRy80b/xK9FdQZKG8BXWgLNFsEHnMnxJEdk9yA/Hh+O9nf39gx2qadKFfoMCzRr8pz5kWsY6hP/PLrTBDPW1IA7zEd172UjbEauntXL1dbTOzVL2aH2wMuDmgL8tjezvjfcPx+0aoJsKJ76g9N0O+/1RG/ay0HUeE7EsdThvcpXo5kffK1QBnriDcSlyWZcTaF9yVabFpiHtNJF1o7I+wnJ7oZCxNmR6a/VviXd1HHHozu40fqpuGRiyzlF/HjskkNQRw5dDL650254cPG1P/9Db7qG33UNvu4fedg+97b6l3nYL51oux58vLs7g83rj+o/BRRWjYPxLMZtrHArHskltiknIqxKYOemSVXsgTdG0a4IK87d3PoYXpjpfjdNm/nfMq0xfbSM3jUnrgMlg1i56X7x4vh5EiqLc0Bm+IF0PN+NaKH8WRaHZUpsiH4Z2A7i80I4X7Si/LkYfeWDhsGObngHJdf/wyTCCS+EWelP3yHYLpThVJ6sWiRzTaKGO7FSk+cFOR4cpFg4MxanH7FxQYSWd1WWI841jh36CW6chK9SL0K9eng/1bRBuxCooKlvVbhBNRsyEMRsLc31PwzdVEFLM9XbT8x57tLs7LfR8HKJLM13udmCnRjpf+5xT7f9bHvQUyK970q+Dc/1RD/B+7bNO0H7eYSegreOutrftAHGnDPE2TnGiYXP64V7bB7lZ/RngWmeQ2Af9uAnPm6c3+mv6eOOFjgY93qr/q6EcT5pYfpubGRa/AWln+11I1PdQRRcTlRDvlTvAyqutYllLbtRkxCZQ9dD/IQdq+whj2svR83mXZ97Lei46RU5wIiaVhTIzivGqKqjo7DhWt6htDV6KNJU+HQW7fOFuYmVvuoTiDCOsyo3ZwqGX6aBtUZv5WBTcOplh7aTxVGtnneHV+IfwVwtZmywoFTDQqtngdz4UmOLdyoDAJ5MnEgVihpWuC4mdk6VjNdR6jTJ+xU2rkO8pWuANb5o6TGjYIOUi0lNbPVdJJVg/YlrCJBAujZIWQOrUP6LFjnoLCjVz4pjQ8TfUGYAiHpixk4WuFBg8jjYqoTINxmZtmBJLaDXmJftSX6W1dTTLCsEVFKlog/yl9bmY1VR+a3sbhCZq9NTsU7DFpm24Pr9MFziCwXj1ZkWMMvp1MDM+ZZ1vk69uCN4LefXtiCO07JVlrULpaEgNgWrMxG6b8CaGu5Dk51PEkE3yCuJMnxWfFEbvlMLsVgyIBZnuECHUcKpNSeHHyOWwaB7kXqSz0nVQGe10pot2zWFuptIZbhonFGvaY5KwquYWD0UJ9Z6oZsEIKJAXFlqyFSs8+c3D9nJVicawK7PfR2zGMzHV+nLE3FI6h/4zadkyLS0MPWdjveck9/lKqDwpiwwpMgBLkzji5ZE8JorE+tJ4CnZzr6WcnmHOjPUqgXF2xJIxl9KEEiHfoB7DZbvx3ICIepsqQGvF022UT1EuhbJmoLXAjky1Pzdg8IUq+K3qdRMqMQ1vUlG5pBNH/D5U0R2xSTis9BPeXbLZCVuXfQQ8edYpro4cxK0+bsxUun2Mdj9omAIlAoBpN4uD9nf+O6KmpAdWKoeE49fEzbT5XyPFcOa0Lnb4XGkvXXhRW+Xc5Gkx/DjsrNDLdDNeC26odT13UY+cS7eop6BBegKBGum7EXk7Mt/xgu1ApuDR4t1/s28Pf/5vb356+uafuy8Wp+Z/nv2eHf7rH3/s/a21FZE0NiDebJ2EwYMkF9i1M3w2k9n4V/U+qaWdNCv+VbFfI3J+ZX9lUk11rfJfFWN/Zbp2ySdoeq14gZ88BTWfagWE+6v6Vf2yECods+RVlbR5AqaDl9fOlPvNTuqkUrefUbyQEsEmHTNyLj/MtmUQOecXfyXFcowwrJk4oEYbVgkjS+GEQUBaQN8OpgaQFgT+X3Cq0WTpyHHS8VaXnAj3LbqZabOEjuC9vpR3CYNp+jA2NanouCY/kYBcGf1poBD09wfj/fH+uF0cVHLFP2Ig3YYYzOnx22N2FrjDW6w99yic3OVyOfYwjLWZ7+LFDH0rdgM/2UHg+l+MPy1cWSQFs86Jj8B9Fep0hrcs8R9eQLE/4GAg8bwV7sdCL7F2OfxF5u04bqHnQamqyb49tKZ+S+wWojftQ0LhaLqispbQsk2H29c2wZThXupC+xOYOH+RM9kCG1tT3eESHrpwaZDPunLp3YFLt/ll4NoNPzbyGV3AwxfvQduiE6hmE6rs6+dBu2juTNDAmfg0hhttxAqgqN945iXJUH61kXC/PcktOpNioEaAehMoPIccIxtpOWFiKLWD35k3Rd8E+zvOkx7D2IKxwXDBV5451Xk1Yi6rRkxWV892ZFZWIyZcNn787WHeZR3EbyhC5hQvnXfnp1CzpMBLdJlGsgSyfu2xOPa4O0QMJlpSZUU2YpUsAaHfHjo90IlpgKpSthpvvku/uy4TScXX+3UBK5FJXgQKHsViCBiR2VOpsVpY7MuSCycyNwrjo1UPisTdPOJO+34j4Qr6q0ItPduuZRBjlaK5MCQg4aBcZQKjSGmpnfqGWs3kvG4aujrNTK1uj4BY/zmp9d1OiJpJI5a8KOzIS7imhuAyxJDUarcysEQYKoTHBhkykRKtUFabWPp3KaYtKJJJIB2h0NayoaE9Io/P3hA2QOwIgAZqSA04HAvMrbHfhBLYMDjG3KjVKK2Ejuu0kRRsqOuI5GAbgfkaFIdqijQm1VRkb8i2+nstahyYvbp4DSl0WmGlX9L1qNVBuxkskVOwNBkBpkEoXptDb/6AD7+h0Mv49kanh7Svh7Svu4P0kPZ1e5w9pH09pH39qdO+ullf8fZt2z8+zyiTGF2uHX4zaUpvjl+um741+0P+zSDUD/k3D/k3Xwbxf9n8GyuM5MVmDcZBv6bJ6L6/qZDi/bVYd5QLlLLV0OXimq5xF+DHhQCIpKhONEQ3I60qYcdDIUrBVWDSnn5B8YSQpdzCP5WlRuufVvCHLgoBMU2oxPq/GhV0IDYijNlCacv7fJ9IjSvHGdIA/3EHgoFzcD9B/A0IkbE0YUtzruQfjbAfzDzd72+IA0nHCfq9UEZmCyQcUOzXdYAvK67CLa0NyastoutEaqSBITY2VliIooJ+RdwYrrAp+EwWjrpcYBA+ircKg3TAY9BOcYhgNOu5S8WYf0NSTwrqV6sEltJHFA8art4ipciCz5tOY9cXdvOiVasd3hrS6TYxu32o5p9SMvyTi4V/YpnwTyQQ/omlwW9eFEw8pLFZJXG5s+Sr6+/K5sJaz9x4mGL4psu4am67JmGRbM6t8TCwMQzHZL6b0DIFlbTiaoEBT8L0FSQuzpxQzDq+sqGJAE7FpLOimDEem1ODgFhJdNRAWmehp7xIuk4FcBuD0u0qsc1vk67xeTFgxvAVhUsAkriZgyMttZO94Ss2FSRP4PIqo53IHDhPJKRMp8JdV+6kjzvMxnzWHbZTxD9rG3WKHRba27ajKMQnkdXQ8WxDqDieQttM0SqQH7DSzN4vl19bszuVajes7aGZyYYm/uaamWzSCE88leSMeBS98ghNA1nGi0JAeYq54WXMB7aylAU3A02GO+RZ3a5T0Z0yqU4bCT3NbE/4i7CtczUVfnzLnG5jtroxo/tOcJ3FG6Av+xwctuPiqt7cX46XMw4Vs2jV2355Q4B0LC5f2LPzglquthBOvTkHut/s7T/b2Xu6c/DkYu/F0d7ToyeH4xdPn/yr09RxYQTPb1e+4U4YuoCB2enJzRtEMGzw8BEwgyI+zr6z1wbJy0Gb5gQwSScCzG8rfD/CvB9kDbFRHbdx4zHQ7CVXmNgwFU2V6aM4ZFKGhnE2NXppwRoX0qUIiHA7LsWUVXweS8IVEIOo+jUa7rMMTVjQnSrRLLW5lGr+cdON7fye0FxJORrihVGs7UDb7mzXZL42wTokZ79PvrpWzm5a2wooiBxrw894JgvpvMBcySsN28qNrlXu5WQpsqTdNnRHDeQGRkt4wHbbmlKKivV7IRUruVp5xSiDcB3GIcAjdFW+SEGgoTHJEOyqaNUpR9R50hNrkE+hw7afIhQx1OQsBpnaVlrlDWuhlDTFJoTF8SSu5NirHpkRLhphPYYat56woySnbyqwkDn4K2OsjRlRDPaoIYIQnTpiWSGhh3l4lKs8BiymQeFQIgpsdlUl/A4UBTs9C6K+0w30spqMUN/hoIIoQhqVZsEI4NMz5oy8krwoViOmNCu5c5B0JuLdKR1Mxo3IR2y6ioF06VRHfDwdZ+N8chfT321aCg47VI+LmNB7emZxj3WobBVaEyReiE5M3vntIvLouYFcPSIeKm4TA8QyrRRFDzYV8SnECTqb5xg7Zr0abUfJ85B3xaYyxjd7FRDDyzNt8qRmvzbs4uVZ7MsLbDuCibBlQl410hSl9rLzf76l0OpHNjRNCrryy7MEljFMgtXEYkB8dyaqkI7pxS18hO1r56Uoy2lw4AqhWyzPXB0CKTC6VpiSbcXxtrA5xSyqeikUqgO4DfUn4WdS/UO8Rz/LMbASKiWeIWOznSnSdRBDOm9NwKGXNKyCRmzC87Ba0W+1yhrbAp50entosAa1TSWjZkh/enEbdzCIJiTd05MvcfjdsIR2Y0A0hfDcc/mSKyezkPBCmZLiE/bEJX7WWCkWoqhmdeEfu5J+ufIPkbgcFMuEAeNMk6wYeJWJc8x4UQReJam5dcadmGuzQmZFSarWyaJgQkFDe3hsTbqZR9hMeq2Ghk16RBSruxhMkJNvSiBDBx62useNiVcHJjoHBlNO5bzWtS1WSM3wThS2oMWwjfocuAu5Z+MjxkPZOay8BQVetaeTMWP/bDBLJX7TAkt4qgxfNqlBSPeTMX1BeettQVL5m6FJKs5rDBFFW8/E3z9QwYuK+U1GLBf+yoI08tD6oGnWD/eMtB0pkNvxrb3H6wRB8gThOP7C1BFKv0heO610qWsbnCKA9+brCGCwN1NS0vH528dU4KtI2tJZJni2aBLPEJWnkE0n+hGY+0/3n33fXXPLRfW1vVIt8H7Sel4I9vp1OzTsvnNtf4AkW2hk06QpkwdcU7UKORTAut/p3ThUOfJ+KqghNDh+2/DwEF78EF58d5Aewotvj7OH8OKH8OLNhxd/ZnTvdj+8NwT3NpSFZoFO7Aw7Pbs69F+cnl09awTCjgz01aKCh0KSFXfjL1DUty+86kfKENj0U+EdCwK8Pb6IOjF1nZMkLTVnVrPKyCvuBDt58680sbJ9VkDDKjTP2ZQXXGVwWpNsLG2Y0bU/xONuK1A37iegfrmNOkUAJI1+uyj4suTtM8ra/hwZruNMuTkP+G6OFEL7OhJ/qDj+UHH8oeL4Q8Xxh4rj31TFcapmBs8ldvvw1Q3x1aEWWtcK7NLftBnosOklfQJuyS3LdFGIDNzf9O1wDPVMqpzqSgbqhFIwSJaxUmqY2z8ZwhRvb6QU1UKUwvBigxW+XoU5UvakSb0J4D+SMxBmxSdpnX3cLe8o86RJGtiTLeOZ0dYyIyCcgArmTWhAOH25hpajrq/YvOCHs6d7e7O2uL6J47TdZ82hJHGtFLpvEGJ2OmtRE6Z6VEbahOfoGfo2oZEq6o2tJTfm0+h/B4Lx1xj0Xu0jll7pGh5XKTBUvqjkl8Iy6VilrZVTdMJH+owjA50mJR3wYCjRo9q2h9AfmIobJzOvYQO8cUhRSueolmy33O5b7cimL9GVqQRaYy3V5UgreLXAwLa5LbQ3uS+J94CSGDR5GKAFGrF0z+Hho8c+FX7p01v+5Ll4KqYzscfFs+zw++cH+VR8P9vbf37I9589eT6dvjg4fD67qWbT/Td8C8TWJBcRdxrIL0otGCmVxpMJdyX4gWO5q0IvLdgyljq2PLYpNUcyjYzMNEcjiC3+99joCK0tqhU9Ilsls6iDXDwYsFNpo8ICq78SeJ46c+nl/WntVx5KcOJmmxpcwfE+9Jtth+kePZfBU0eLJY2MltKJpKOyNlBTRs/Yq7Ticev8AeqxGEoQIlABqq2nzNQigVL+D4I72x9CQhP1XMx4XTgokljF0JCIL09b5KGJY8qZP6thjNitb6CIdbqGnbQKRxJT5jZiCKWekDB+h07/Pfl7dzpd8GII96BKOyi9D0gBLe4a+VoizoSVDDXQPJ3hIE2VFDh1bejaxDjqUEccNJZgmrQ2fqi6efp7azs2l3m3/Z8hY6a9IdHP3JLI+rvS8DAo/6QvGfenBrPZhGNaFauuRHbVTMkj+fVrrY4PxmmpJ3RHt4TT5ptrZFN86ubghODvBqjQMrPbvkjbIyVRCDfEH6TWJwpC+Ca95OTvf/CSP3jJH7zk13jJ8ZzQNqUVL3s4/GqucgTpwVX+4Cq/H5AeXOW3x9mDq/zBVf6ncpVj4eY/m6ucoGabdJXT1X6Di5gX5FdtTq2O3uNBN3ESMc2c4aAAqfk37zZfi47xF+LjG3Sb316o+4q+8wGaf/CdP/jOH3znD77zB9/5N+U7d4ZngaOTefIi+Wq9ffIk8avQIMNeRK54sfpDsEoY2FIFVlqj6/lC12FHeatHGoOUTScyV3uFovDkAGIedPFpGj5lmS6rQtqFyME1lADO4LV2P2jLdpqLMyS7LcU0NmImM93MaOV2hMo7dvcdvxxsJ2hZyfO4joYupjy7TN+8Q4tTD73YHDNc767GiRMnGn6D4NpmbeRshSZ1SZ4eedOw1gJzei7cQhhIDYxDNrcrso6A8AVXeYGbF6cBAWyHJM/EadfXzA6ns+8PZk+ePn8+fXKY82f8SSa+P/g+3xN74vD5k2dd9MbMwn8PkuP0HVSH70Na5kLOFx45Ud/GlgKC29qQ+Am5pNHVbuuYfseo5BLh1x+/EMnYQ9/e3mzv2XPO96b8+72D6fOEK9SmSDnCh/evb+AGH96/Dv6FyugrmQtm6wrkcaxM5Kd0wKQgEIAX/hVqa0BPxtTkhWBTIzimueul8iShmc0WwoscKISNoJAOva9ZkHdvc9A2K4SekNOAmLApRrGt4tZyuQy9b8eZ3mq7i6HCQsbBgQH4LPkKE1op4dJrxNiDAfCKEm6xagqa8fbSGFVlAFc0dK60YkSZ0E3XL7DOzHXsP0veBXJQ9IimvYQWXmeGz8vNNSnf9hpG4vGrTcH4zFEN1clfJgmina62Ok7YyV8moYssNc0lXo9AdySJDdYDPJ2hAO3pH1xVsvT7SSUUIAm2tqLZrVXiE8I6m3FdUrFJbQqQ+ycjtlwA603b0EkLeeHKOlMDN/XUg1m+4bZrO8RS1W2gsX57+48OD5/sotv3P37/W8sN/Benb9PF+T45L3YlhjVSI2cgERtrR8TV9k1JSaSRGujiMkqL9ubxdEL3mrCZIyyFwG26PTyDaiKFnpOB078qLdV9+622rkm7Dj18PGNb2wU51tqIr8VhOYheS24joKMW4x2Ml/usjfWjrfm5Y/CwNtnJ+97zMxq+I+Z1aj1xtym16Qw6L7fmTngQIWhrfIPZ5R7qPyWmlx4ch4dP+kWPDp+0gII6HZs6mJ75wgRExNGYD/DiL7i2wTWkgs1Wh9h6PP4/gMeLT9DGKWnCmc4C4Zh4w8aO6Er7d+GEJl58rLmdwB4iObEeN4f5prWLT42SyXCxGPUaR4y9sMvKNfAA6PjkhN7uRAu1wuHYVLilEM01D/LlUqPw0LnIUGra1N6ew+jrzwBwl60On8U6RpOjwfsY4V3Dp3pq9YZtXWlYZMJcUghaYrK9uVTMRbBGduN6hssww6N4L/mbvBBXPF7WJLG1Y31+TMqY8it0jghwjabmC/+NFJaOQjD7YPtjt+CobMs8hMwGkT5WTKKbEo6ZTTTt8g4BQv+/tQX/O83AfyIL8J/A+Pvvtvs+mHxvNPl+c9beb9XQ65/6yOdB10uuLNZ8e4uLC8cI11eTu6NLEYpeh8qO8cok4C68MksVrxd6yerKE9RSTGP0LgQvJ21QYH0VN14MqiOoQXC6w10jYqD8VzjJNFt3S+TZIoRnfoUSvylADep6QJ3zGTfya2rqHxRt6FU7grshroGIvD9kUfDdp+M99gjR+N/Zy7MPhFL27pztH3zcR9N0KN3/mB1XVSF+EdO/S7f7bO/peH+8/zSyk0d///nizesRvvOTyC71Y0Yx5bv7B+M99kZPZSF295++2j98QXjafbbX7Vz00AttEOqHXmgPvdC+DOL/sr3QNgvqf/a57pqrwXPB73b8JEdsKqAzNFfZQhv8uJPpsgQwSZb4AZ9pzfY/YNCXwc6Cr8DrMR0lKA8gXBZUpRIWCLrNYG4JwNvp8TmEkhYs3cadtOrWyB6ysZOl+KPJpMCBeSGjabfibnFEinfn4VLODcf5nKlFe3RcS2tYPf1NZEHIxQ8fb1zJ/4i3WMQs7GNoig7opIydNgTCmOiW7QpOayd55V/qdFaBCqh5Lh1WoPWyO+QQUb4jzBNrUad7yIaz9dbt4DVgNaAl6XCtjexRR38TY8LvbfYPBh0ku/7AgzR67eiQggTBBeOQY3pb0r6QmGcroSo2vutVIzq9WaHrvDmoL/3HYNSBTEFOpQwGMP2GfkV5PGu9aj0JUOzFAjKwPsIDH8OQoSi5NulRbq0aXhhXRnvSb8wBkQvRLzufrqfRVNylVzw9UroNrBipcWByWfK5GJial3KHT7N8/+DJICttZj/1I7DTk2hjQDyFrSDa/As79mSCSfOQfB7ZQQxLFo6PI0oAyTfQ2eDD19JZMkcAsCkScf00cUHx+TvPdIuj05nrtucnmY1Syj8mDOb6yeiFcfLCbeeiC0wW0q0+3uLauP6t285KNH7bjeudr9vOg7kEt5qj9ejg+IEf5Tq7BFolhnQSPg8cL/wNUr+7Cb30mz/XdqGN+4j33xGb8cKKRFzB+XYiM1ojVkSw2ODtuO4WoxsxDT0cRlaCsOFXBpG2ZirPce4+G3C65EDdcdbOm7eb9POnK/hUFNYzzot3J++8BLdkTrOSV57JWvEfPVha4hS7XqRi14sWyNMRhHGgXH+fN3T7M34aGOTUy0MJtdK1AGVJAq9JCNR/P0iedG+8enmepm/LmI8tMjtelcWYnsOCQ9xQspVWO82bHdMygn49pa/fmpb9Nwwx1boQXN0SvbMGI+CNaba9P6+242kti/6U/R2Nt/fW/ouT/b3vt24HzrtzBjO0uwgPAZLpXAyeg+tgsc4Ily1uD0yYBR0sahUp8LKeQn4KJMMRHf49/W5g3Ob3KOy1JbdmUJZS4fVctXnpRs7aAvp6mutivNL5MNu502FOMFBp7L3U31w/VT3Awz93pjOdsw+nJ/2JID+x4tn9LaoZsT+Zznss/wsnC8a6/mTELv/6xYw5+fljyatKqjk9u/XXW56iBGK6SEpe9UEGLxO1ofjW4E5gGwbeCCgSYYW73y1uxl2z0bmoCr2CyMl7nbgZd83EUANoVhf3vuRk4DVT3yAHfe7Ecdgbpx0W+r58XhyXLpimA2+v/+7AuKF9XLxXolI7dA+k3X3vcgmIT7cVO0MXtF5DVzYgetKKf9OFvpR8h9dO59Jm+ipVTv5v/JWd0C8rlj7HEs37RuvJwFDpLUxwxCHXmT/puTGamNrm4jvYDoMlmOqv6FkEILEHD88pr7NDr7Mi8mxB/tsFmMWjV73dEE3I0E/KIyFneQ2xgVALEpt9RuMtCMLalFiwIFo/IYKg4oaXAor7GTYVYK/0+yYc1uiE0Cf4wn/EyD2ZA2hWXEFVy4obZzFa7fRsFExL1OpzBFET4LdqgcRVjq0MwSY5hELKx6iMzuvM3R2RF1QdBM8uDePFxLi266b9bHJpTbtto4vjUTLz4xumVnnH+nzrmfHdtDgKLj+hBRurC3ZryQQ4QlrLnWf/8P41W3jlE+qGwXRErQDJdUjPatPx2rTVpDWz/hJj+cP6sKAZkjiplLx2C6GcxNIVIcY7sLVyZX8vyHFDvCz9Kp14DaOBoi0di0gSmWzE77U0Iidueu16zl6/Oj5/xT6cnRxfvGIn715+ePPq7cXxxem7t9/9fwEAAP//r2s40A=="
+	return "eNrtfWl320i22Pf+FYjmnMieUNRieWnnTF7UkrtbGS8aS36dmXSOCBJFEW0QYGORzM7Jf8/dagPARTZBu180b+ZZJIGqW7du3br7/Uvwy8n7t+dvf/pPwVkWpFkZqCgug3ISF8E4TlQQxbkalcm8F8DXd2ER3KhU5WGpomA4h+dU8Or0Mpjl2W/wWO+7vwTDsIDfspS+v1V5EcPfh/3D/kEffr1IFPwe3MYFDDcpy1nxcn//Ji4n1bA/yqb7KgmLMh7tq1ERlFlQVDc3qiiD0SRM4Q/8CocdxyqJiv533+0FH9X8ZQBPfxcEZVwm6iU+AB8iVYzyeFbC7PRV8KO8E8jbL+GvvSANp/DK7n8v4ynME05nu/B1ECTqViUvg1GWK/qcq98rQET0Mijzir8q5zN4MwJM0Edvvt0z+HofxwzuJiolNMGIaRlkeXwTp4g+gD6g/1whruG/+FBk3lOfyjwcIZrHeTa1I/Rw4ngUJskcoJrlqoAv4/SGJpIR7XStG1ZkVT5SZv7zsfMC/xZM4L0009AmgUFPj0njNkwqRUAbYGbZrEpwGhlWJhvHOewfLckHC8hKxbcWqlk8U0mcWrjeC855v4JxlgcwEY9Q9Hmf1CeACTd99+jg8NnewdO9oydXBy9eHjx9+eS4/+Lpk3/tOtuchEOVFK0bzLuZDZGK6Qv+85q/ByK7y/KoZaNPq6KE7YEH9hknsxAWbNZwGqbBUAUVHgmg3TCKgqkqwyBOYTnTEAfB72VNweUkq2CpeAxHWVqGcRqkgHc8TwQOkS/+5wQQQfMVQZjDjpYZIgqwKpAaAF5pBA2ibPRR5YMgTKNg8PFFMRB01DAp74WzWQIby6scZ9neMMzlJ5XevsQDH1Uj/NnBL9BIEd6oJQgugaxbsPgj7G2S3QgeiBxkLNl8wQb/hE/Kz70ggzGm8R+G7JBMbmN1h0cC0BfS0/iFyg1ScLoCDvKorBBt8EQR3AEPyqoS0GOp3oMBpoLJc+EewYh3FgADLKnUIXzYT9xcmHpSTcN0L1dhFA6BlRbVdBrm8yBzDpx7CqdVUsawB3reAjYlLvDET9TcTjgdwimJYHEwUZaap+sn4meVJFnwS5YnkbNFZXiz7AC4hB7fpPDjdTjMbuGXw4Oj4+bOvQb4cD3yXmEoHeYJVDia6FX6h/V/7Vj62ekFO0BSRzv/2z2qsKCUKUW4+on54ibPqtnL4KiFjq4ArfSm2SU5RcJbwwBWU5XCBcflHR4e5J8l3m9jTfvpHHEe4iFMEjx2PZin5D+AdLJhofJb3B4m1wzJbJLhTsGvZfgRfprCNQfENcUHZFjzWP1wAvdPR0kVqeAHFSIboLXCGOEcOF6RBXmV4tsyL7AXutBoof2/ylJlyGKCPBLoxLBjomyEP4yTQtMeIwnGTfGcZIwghM1Znz7vcLHkLvOeAG9QSIG4WDqpZqnE2BEBqVAjcI4SuBnuuV7sy+CcpxuhIADw0KLp3OJB7Fn4+kgKgQgiQ3iq75zfk4s3JJLIxekvSHYcAN3HpcRw2wWWNlzmG2VKo464LskZQApMLTA4Xq8wGNDczST4vVIVjl/MgSlPiyCJP6rg7+H4Y9iD6yqKmT6AtkdwJuFBvSnyeFHBgQAMvYZ1lmExCXgdwSWhW1DGB5GInFFopBV7OtRsAvjOw+Q61lxHzjPwV5VGlhc1TvXCc10/S6/0HEEc4REBOHImH8AKI/IR4Ak5ELGp4rGhay3T4E0GiEbpQAtw4SjPCrz8AQE5nqchHMcBb3ccDWg/cCcEGQ7TeBEej58eHIw9RNSXb9jZFy39Qxr/juLN/ddtrlskUSZseu+O7nU4lkTGcbRweZG3PPz/XSxQpBY6Xy5HaOwgrJifYnbIV9ANiG0ktsBHfo2flp8nKpmNqwQPER5qWaEZuLzLQBbnAw1HEeggHYkYU+NHBU5MTAmJRK7TwF6nahbmoYggsnygHaUi1j/uJjEct8ZU5mTDTYqToXjtrBvuYRB8NeehpTJL0l/BtQGrT9QYVKXprJw3txKYnreLuFFd7OIVvLp4+zS3wwlA2gnngOPkDv8xuEVRsJho0uRtFWmc38XbvG9RkxqebbBqn2USlylgOPMIXWFADO7G2x2rE4C3+VOQIFAlaKLYHUfjWZTNDlD976LG+siuwfQMNNyDvXx05IoxhSfDVGWWZtOsKoJLuhJWyDMncL7sK3yLBI9OLh/zwRTpRAADUSdVpDCep6XKU1UGF3lWZvCUQPro/OJxAJORugia4zj+BHiv4LrgixyFpTxLcDDkbnB2p4AbOFGwc/lHkLRRjcxyFHi0jqdA3BjjC2GA9x2cyjCCUwVsEU/mrRaucKwom7IkBiQhaisvYjrN4IiNEhXmydxgf0xCroE2A21kToIlABrLAvtrX5hpNR0agWbZVZlk5tb2tkKuBB4H9dBsRMKVQNTYJpE3zNeG4GUXZSDYzLePYQtwcLglzY1TsPBsUM9n4txbt0N6h08Pn33vLTjLb8I0/oPYY795jWxMTHjnzENTN2D7KcuQLl6/PnXOxSiJa/L9qf1miYB/Im/iAdA0EhZCFHEZI30yOWrUybFA8OA20sCx4J6rmzCPSKBDeS1LQR6xz7MwN4zZAgZfgCg0TrI7tFygruOpk1enFzIq3xYWzAZs+AU+7kBGhwJOhBHj8ZnLf74NZiEo7eUjkDFoFtZAZ3KsG1OxpQfFLW9SrX/kZMZSaCzQErLGEhzXtAgJmH5wmQHr1TIrXAH0JFDeNNjR5qss37HaLnASzUEElLS2wIKPg/wsuhnvLNwUWjch3cxBgBwVBAu2SLbZTuHCz1qmEJGeAG+UqqgQITKqVYrgfQDvtyrlDSAdibUebVxsGcziFyTUxpAo7PB+7dEp01YdYwvi8fb1PMZ6R4eHxSc0EBUKxJwyHhE/hoMqkpb6xDJ0jwWb74zEo+UteOw2xuXGfyir8OJCVU5KcBGXVSjbAWLOPKtyM8cYlqWJT3Np5HA3WQ7KMDyqBYWijNEIl6LKJ3TLJkMUJmBLSyQPRCkiDK7pxDAZ0MbybJYDSQKru4eyAzgBPBVd6TlE7azZCm3JhCKTGDYzHcY3FbByAJ6omd4xfP0O0VLAWGQqBc2wIFvS+UUPmJHcfWjBRGb/CR5EOukHwT8tZkV0IluelZZhojy80zBpuh/05YsBo8yX/FJUjK1gF1Vsy+PratCPZwMEZdBnsAZo3QCNPhLRm+VmkACskIbcRXbMSjb9/+8uVVjzN3qvWhiH81IVK0RgZz/YEuK/5gHyA/7AVhDjiJBzItvE7KyJvhfHHmBMbB0I58JXefy+N+eNyvojkD+vO1KkT1G2bd2dNyhLqzBpgpOhuwYA7gqmt45SbyZrwPc2y+HGO5mqHDhDC5AVgD+/jovsepRFnaCOpwjOL98FOEUDwtOThWB1tZsCUuuGnoZpGDUxRSxrtdIJj17PstjcF74RHY4jXM0R36EgTNCHBgS7/yfYgZO78zLYe/6k/+zw+MWTgx58FZbw1fHT/tODp98fvgj+724DyA751O4HOP57+o50fmIpXKMHLkG2FbBkBL/dgMQJglMOJ8i97NDPAZcuiYLOpXaq7zJjiWEKj3OWckYKubgIxCCkw/XGl0GPLA+T2Iqb9tZg8JJgNpkX6MQ0noCRPtaFA8LbrHS8neTniFk/n9KlBYjWq23aK4YZXOvpXjRq7A3oIPBGlyftPc2w7KDt/eN0EVwdHTWBqfWk/aNSQ+UjKp6tgME84BPn+YURnDRHpMvCpSw2WmqDh3bBnV/cHuMX8O8zKxDWZKBpOOoAN29OThdB7duGy34dL63HegFurlDlY80F0AQTiRzP8RtvT66MUhw8Uv2bvlhd4Jg4yrtogNog47kAzFlx9EBUNMlMB6JmkoVwpMMEzX94dMegZ9+hGkJ6N1p+0NW121j0DK6u+wmdWsgpyjxul0RdbOD4fxZ8sL55D3nPW/UFv/1Z0t2RD0djT9YROhfvx4XswSLiB/ab99skys1dbK4cxSYgUIXpE07OFtipIoUjGzv7/KP1efRQA3x9dnJBjr4RGUTPzFCiFBIP3G2uTsGvSUeLw0s7oAk0p2lB77hKkusORQcEYrcIcBqalq7q8BaAQvdOg+JOEkB1GbxCj4GSbffgJStCvzOHaNMpOBYHOE1s/Bakiu7PgJcgmfcXwdkhYl3K5cmaQEzCYtKZSMiYomOC8yArAXaQK+Svnvd9zBYROk8gS6VZOndjeZhTOGcLSEY8iwNaBXqM0ZJBH3B1AxPxAf+Oea/Qg+3MiTL2KEytBS/QEVptp7ATB/O7mrBR1UnLXPwEQxOqjqSyywmyXRavKRojTpuAOEcypCPpmfWzKvKt+vqLxUZ9DswMmDyM8YeGCshSPc5DE61l41DYOsdOXH2vkCt3cdzJOHij4MoZsT+4cP3NIcarHrG3GSlkrMrRRBWkXTijBzHMy6E+FkikLj9CzQs1igvjx/RBkHEBCokhytUUYNZPB/B6ATThzFSHjGEKAwly0Qty7bjyqmhGfjAdD2oHomgemVzf/ThsXFhQBWH3sd+OSG/vjjPvXlkE8VwUxeRa0OLIRKbJKYP7Nx6PVe5KbqT/xRSPhZc7Hs89jO2DAVV6G+dZOvWVB0tbJ79cmsljwLZY54j+g3fvfwrOI44dIw9O48A3NcZnz549f/78xYsX339fM0LyDRknaNf6w5ppN43VE2eeAOdBrLBtmGiajoo9RA3mUBV7Cs7t3mFNlROHf3fkcK4DPc7PNPciWPUhrAMa7x0ePTl++uz5i+8PwuEoUuODdog7vLINzG5IThNqR/GkL5uRJRuD6I3mA06QyVI0lkf9qYriauorBnl2C2Seb0HUYQ6gJ+zrw+nGSYd3oEyFf8A90gtuRrOeOcgYnxDfxGWYZCMVps2b7q7wlsXWkY4WJcaRzzxu7nXMjF6wr69k78slvnbzoO9PFU9nI4zdiaydqRFwNW0bMVCwu1Bc4qJdw945gzg5EapQel50cDoCJN1XrJWboQu5CdM5IghdcPe4oDqR8UQItouPI/8Mx1MM2t6SGkCTGZcAA4SxusMqTkq8zltAK8ObjiCzlCVwhTc+AE6ixvLZnYSNJSkbdWZLk0r2w4p4yw7WbI2ehpswyXbFTnh0YNxpeIPSG/ETQwcNTsKJIg4bcbz6LiM5q329hJU4jy6P/mDp2XmavAhs5dr3EyZaxnQCPlaFejD3kVCPbzEWwQulWCsgwYqxnGO1oYAE62THwISHgISHgIRvLyDBPSzabi1Jjl8rKsFlTw+hCQ+hCQ+hCQ+hCQ+hCQ+hCYtDE5xL7M8Wn+CB3lGQQjzD2dybfoVnXnku+Vke36L14OzNvx63OeXp1JBu8E3FJZAj3LGXyErJimJxA+sbzgkTZ4qyXTe/wi4iDe4htm0v3GAhLT/EHDzEHDzEHDzEHDzEHHxTMQdR6uXYnr29XGWN/NGzQKKRCV7C2g45mmRwr+BivVNOGR/8XYIOxIqlYnLkmhwumwCrx5qjyIGnNQOhteQUNh5Wp+MOAHRy4b2k5wePpaLGXE/ijk4sS+eAMUHZ2iYyIk9rDKpFcKfgpbCgukk6d5lhYF/MHVb2EI9ZJLwFLhkapwklvzp4fB97qbfijVvydzFDOs/DuUYGY1ne5/IDaGFjMED6YBNwrsoK5EN75HVhLIl19LLo6cIEGARl1oqp94a3APPiuUaTZ6SFkV+dXtoc+vecO8pjTUK4nCnH2mUWU7sc/lFPjpZQeAtrv/DwdR0QtxnJj/ROlqS4hAH94hva8TlN5sFJGWDW9rSa9uRL6xmVRU2rovTK6QxwlgECR2EtjWWgsKIv1h4samaVWxwNLbpjNORKSTf4cpaBbDtkESaiVEj058G/sc6+5YOrrbHtgMJAIy5v4Vn3axTZHyVhZ3Z8jkcJWT8yG6I9LhFTTExVUFiq54ziBq87f9sKuhOT1EkoDUHrcEcy+ata1Tg5HCrkgCBtyeBX0XhfaOmEIgiIYWmUuAPqtTfsEocHff3fVix0aTkiLFhRGSnOccXXQA9mnF9b+FVEQqx5w5fZ6duTN6/wQAwVIgvfT25V1HOZ0y6IpQMWJyyLKR2vTpbqKiwo1hSzDFFM6pw9DDQInUs4k4ZXYWGjIgaszhtj6kpnA8oL1y6EAd48iooUNrbl7u6uf0OWfqzX2LozZZl8gQ8GcU/+StLib0mSQs5N6yUEtG4Cck0sjwYYdRm7GhNf8rxPcTEKcwCnH/xL5ZmOD0FS1uPLGXDwN7RI4ylaPAvtdNphjM7VxMbnfCaLIdL04J6oMFL59TjRleI6OF8ndGcD1EcwdgnbTFySZw5oZi/IbsZ1TWwgD2hmJ73g6rQXvD+D/8HfJ/DvKfzv7F2DZOXjHjxr/zxZGgGy0R3CpbH1xFXkgBXCEE75yzy7ycMpU6Ap2enlgZNYxi5HZyDy5c9i66Vk5lA0tdlnR4eHh36VkFmLZXfji+fCMSgT4GQiRnGMkOJgoI8xXA9ADizAejJtYOobcpEpU3m0AHwK7mxVCjbt8zAsIxNmqFaiO+ZCHP3jw6v3//RwZDjj1iQG+K+UGJELg1WTlfKBx8O7vBrpTqyB5l59xhNSizcGXXsPlCL4hPW74HqkCregjDwaKiys8uSIIhIQguDw6NnjnkP+WeG9Ydm5UZK4FAwAG87wWGGlqsMDukVuaI5fz87OHltJ/Ac4kkEBOJ+I0vd7lZFn2YwsQwHhhUOsDAOaRoyRQ6w+FCymYslaazpQKnJHgJWDQiEW2l/LXvBrzm/9mhIJKrLPJfN7XbNmm9EPUgA2VHTdrVkS93wS30ywErOdVCSkHtlVZ4hzEe2Kaqg93u0WSuZStXFIW9sZZ5mz7h086DvO53qBOuYGUngOa3TmU7r+gCRGcYHBFiQhhRz+QuUaidlWwyQeAaDjcfzJjEjPPMKi1C/39/kRfgKDLB4DGeRzEoczLmXyKUbvGl+zwIhEwirDj9bIzHwbS1pT9TMOOePIHBQqKOqDdHRc+9XrM1sicmeU9auPO03CWEUUWxI3ROpazp9O4D+1sFmWfK+/xCd00lD4AYHnF3gjKIqaHbiK0qCmsegfB9pwILQTw0aPqoT00aoAIh2qUVgVxqh5G8ISy7kWtTxrIZZ5jLk8ooCF0cdUv9vC50RxaUBLrqyaBWRjcZAzsJcfVZOFwbVyzOmlkfqEb0+RVNyhmbvwS/S7CgsUEsrMjGhrBDHTw6sSFrFYbKorYv53h/UNpnt1G2KFnqvddfz23av379+9X1GIcbM8Uh8OYy4EkphRjemeIBqvN6I//8KkUkw2ItoxN2Yp8BQ04RRUhMkxVHpVmeixUa50NXqCL7UViscMW93iuC4UFgBtPhTjogdEbX6qkEpYAPlP1v8om7EtB8CFIYoMjeOFlW5ZsFMpcNsTtAGJ4lcLbayd/cVmT20dRKlQeEKDoRozkimuO/IMytxOYJlB+Q3MvOeavnQAvNi21i9TuKqCZUsbgi+r8eu0aKB7zOAXF4OWtn4wAHz05aEBuyA1GJYJkpxHrAfNhFQXl7wrSaMKWhD8gvGgtGe0gVwQ2PglgLfFaLbc2xOTi5hDqaQ6doRIQBApk7b0LWc19L40sUDQEnQ+kyiYS7W1MPoNQdVuZ1Dpp2EN/4FXqb2FdLCnxYFLOXmeebkWr8wXy4uW21yHEVW41aZlGrBg8p2TlmTw+IHr8k3ZyM3PiVEZy3Mj8gCblCyIaNaMgJxeWMW1sHW93aKImOCokrGV2bHyHI5+D6N/R8FChExWIWvGSQZwazkdLe7YFgjcZgiLwTANEVoXq1Vfl8Zua4UzX92uWRif97ctl0cXAWxP50myG1tBckrZqx6tGJI8oV4LfmVOJJ86z3Zq0VN8+oT3MbSl5fVt/tr2hCDGouvkl9pGiD4sMcySJA4DmTFseX2YyC5CxtNDhbpce0AVyXU6sCT52jqiYsFhgdeEsejShvraRN3AjSDpcYh9SzHSIXyFYmBoqg7KfedU3ufJpI4nF9wfJRl6jQHXshOr0c2hYzIkV/StOLg2oRG5yiN9dLsWEEDtiHYe0z0CTN1/D+sutViUT9U0I/coYB1TumW4yEG8JbjbKkFbGOWhxraxgjxcoFcdPlBbhfukJJfdFIrmDhS8w1r201YqP31FFFgT+iklWx3/mdM2iKz5mEeOu2elC3QJDvgBXatz0G8kJ9NZHxBC9kBRGPSCgZD8HpG8oq+wovYeS3DRgI2M2tRmRjTV/B3vJmeXwTxTinNtXpLoxd+bhUWByNxj/7V/XQjoXWzHK5HCeYY68s0lh8YNKdrazgOJQ2pJurYrVlfLdI3Y2uYwQQCWZU+BfRRiB7Vhu6EB08BlR9bSUajL6f4S5ni4qZnGuKLKCEb0ARhBFOqBGBzMkjDl6Efy8Qehb+xAwWI0UjM2yIl93YQBSNubGbfsQl2YjCmgFrdHEtNOU5aZZQ2LZYLNqV7nch+PHCOzWYQ0zfI6Njh04GRcaYc5LlQz0Yh7jpmaAaYzT5U66Vc9qSSd2NSwgNkf9w+CTbip8A8ACpdHci/Jn8xpMywHTEmrWPJd8Gl8qg6FIfH8AlJgdlfwvR+cnzX34fjZ8Qsf+XysVxywyCpvPn6Fw/AgjUIX7X3O8EKg1l+OyT0khqGbRnB17TlrnY3mX3JCWXpHPhnjnTqS4FHbrs0UK3a+Kt1KW6W1iwb2OmvprmZ8oXU+fY5hGEXplE/uScAH2vTMtGLXG6oWFYX5qf44cn2JXn8wgHpEWYsSiZqQU5MFBVc7F/+QRLswidvwCvfepm2hV3VfJGzHJvwfPSO15h0akmkGUkdmoyvsEOhjzuyO4UddJQLe+6jULKhmzCnoJfdw+VilZhIEqY9HvK/4xAE+eu7OWst6S+wcmuUKVXZxjXhxuTxNzdmf+v3zyHpMnoUpJ01iUJJTTQEF5SzXghFHrSMndvgHPNJjvQL/fNxzJ8cToXeKxYG5zZJ0TuEIuJsdsd7phLYSDbnTKXFiarOCdmmt39PwKCJ4c5NnxwQeTLOocrq7cFDtOEuS7I4FBEw25XI5aWOYFmvMDL3rfQcXZnurfJ10ppa479qbcTqrymv9YxqmmUQXaKGzKt0HwuINnOu49Rl2MxCNHLYSzplM7ckNAYWVmGl9SmLuw1jHk8yfFSoHGAqWZnep24LRixVp4zCafdDsKRtpZE/jRhC4StdxhC+6KCyojTuifj0wveF1qL9HyebWzbjCG4Q8J9KOrFY+ocNg4p8xfvjRTOWgShbUlIyadYHIe6Ny8l8+JhdIeCf3E6ZfYGIYWeet/RXUnJQaoXDLQjY/wRJbsht0/Zm2v05+OD3bmm3j/AxXY5LzHb1lnX5VaKHq0sCu4wQWqlNsqG7K8Hcia9cLjni8kmm255T055aQovM7Rt0lKkFN7aJvB3bMAdBSqVDhCpMwnw6+TUmegPStWS6b7+xu5VmcUMJlbbpIuhA5hSQhEnCKaobZQYXeI8QJyeI0NIsuSXVDzCnTgpDvX2CDq/TDkgudr+gTup2IJcCFLNodj2zC9tpkTpsWSko8Pr/o6qt18WGZtAu8vwf2RVY/raUAfChz5YaUP4iEsYSRLZDWUYggJ6XiCyfKRtdOGSYQ5pFMI1KgOS+C5GYV5hhaZ08LCiSx6TuXozVJ3WqhfXDNezNoovIShNDD74ODFy+Pnr08PODiSaevfnx58J//cnh0/F8v1ajCBfAnbO8Iug1rrjl/d9iXRw8P5A/LFtATUVQkoWBU/hwrY2D8g36B/y3y0d8OD9BD0D8MoqL821H/sH/UPypm5d+AufsJbcBmUFbrknfKFIvYp9cF2lqlUFsbsSXTcpLCv+C9kZ3ebrqfkLUI8oPCGgWF0pF4HMYJ8L5Whmh91+swxvUZohl3fcZYNQXTjkuc7V4aj2zbvrEZgHJBme/pCJLLeSFaRtNqgHZ1qyWjEcL1evnlubRqow9rS6q77aW7JHqRKYv5KMBGTd8w1iZ6zIURqYdcNZTKKTKwhA6alqtmxEcfMcMXtMM3MToQYf49WeKePtx7JxVmDqQ3j5v7yG/7oQtx8fG6cHjrIm47TrKw1WfzHkYIaATuAhtneVz67Z5l/YWACIhLiNIKJzANPXus7NOSSd0W0wTL/Oi36y+A/RpttGtQ4sJF7L4lIy9mxNGwKxbUM3Z4sliZRRzgkQTe2dJRFIO9OB1Z8uowhACPnq8qCyEQRXGwbOEAVPj2DhziLuSq5YVCJpDaZTDWxNGMLmnpbVZTfgpsjmZVp83lcF/KwLoc0EIBVhkY9KPkbpfG8mJSIKW6aJgte2S1CT/6Af5A8COshgrsSdI0RMJx7JdivUycfH5rcTEabgNZwIuturaRLOxLGbPmFDHUr+esSdy/SMy+Vby02c2+4cb4Iw+7pYgR85xWkpEKyTmOzG7XioXVzLSetK4Og3ByYslUsdJ1idMC4xvRsceEp+Mg6qVTntcQi7r5FyvhrOGvVMPF/+Mq4t7tjQq5NeUu0MSRWDqsQ7vriJZO8p1tb+4tCU1alnqd7t6BCKXiQBaYfVUxQRv1XHh0pMYhxgPKPWrtkw6rZhOajmni4r53ceHaOU+sEGIm1TGDlMmAfp8UtBj0v4L2zZPvvKpyuPv2T6YYIxuF0x0nGDocDnN1yy5h/fjl1c5jji4Lfv755XRqiRtzkeWpvYOnLw8Odh7XznJX/YnfKyYXErtEta04nsGs5YIlr/A2oxLNpjwh7ze+SJnaqAwS1BpmdFe6URA/6s9Lm4fiW3WPOSUSNKwCFIyA8fdAXL77RJz6+Ct5k7QrGseWqnCmMShOp/MSRXSCqywb8d6RqEmqie4c6rWz5GDOfcRdnCg/eoE2tCdh8SCARtWILwaa8lwraFjrS6vH/+vH8zf/W56lSCDdnJ6LfFOLUQoZYglfi9PN8owhEBa7TvDx2nq+qwt8JmbkfhXDyTvxBWxw9zUFXcdTJYXPAVRkZHpoP3NWBNdUcmjtVhbs0EBn0UetUhRFm+m01cd2P5AJ/TQO0SDOsS6Utjaj/34NxjWrjN4HqWEJmwt6OptWsCowZ6KRn78dzfybyeOlYcSaxj60akaX1WCKUw3EQYU3L96uA1rFwG+8iQeLHKp4qEvbQBwf7WFm/sgMRzJVauHW0gSCUfcoUTGdju41rtSzoCayAaihgNYKvZkqMV1BaUrHmHBBw0WlOmYDxv0JSKf7IHyPfGsWAVV0Vzebzo+ZpAHWTKROU0qss6y/izyehoAyLtKCl/pP52ePl+7rLuhKh7XyeIZHdg2hq8q3QtfcS3S/9KfR066qz5895SmakxaT8LCjWS9/PjlcMu3R02fdTQyDL5n6qRTA6mRqGLxl6jjtLmTnHMe2cc46jpcZS2r+1uJU/awArp68eLJbd1B01goBgXWOB4KYjUqQq/0W3k1AD54dH9TA/MIruOUGNldnSL4FDEyOtlbW/G2NdYiGZSKzNTfuGW+aV9usgTL5o19n1tld2pmFlcV0nGCXwiry1tqPTR44C8uuXNA/YrUfHN8VkpZdtPuLEFfEf6gvqMbAQikOglRPRZkdme4dGvJyBQOj7Y00cQokpRwjkrR28GNLGuMhnGC/EnMZ5jeqvO4QqVc0A6MVNctiPk3i9GOtDl2HSWKES/JCP0K09PAckDIpkDxu7LDR/Ewprk5LFZCujfLKB5JXcmuodnIeHl3WhBk+O4tFGqd2q6uy/yQfl2js8IibGIMZ2HO3uVZovfK6wK3bRyxMXa3ZaS6CkQK2Jq6n+ptc4jw2nsZSjSYUHmG9KwjZ+YUTp84xafke+p+T2ASnrZUv8+2UAf/mS4B/g+W/v7HS39982e+Hkt/fZsnvb7Hc9zdQ6rupjuv7y3yx+Aa7MqVanbw79EKRp9ImetIzksCJj2iZ6jtTv7oWifc5fa2+qbK0265F24gblV38WX9ekT054RBQaVWq9826EOn3MMFMunIyNdlzwBLY9+g4BdCzQedZki+nU0DYhAvRsKfmzdnTHlkjHhM1wGzC0/rBSRRpMMbGhs9dJWWI4TzA4Ot8FBZaDfOBY5aFALLDpQKs5+zmL9QszLGQkuZLaDLHYiezHG38waMiRY8n+kh7AYcyTMIn108Pj+5TFXTbdqPtm4y+jrVom4Yic56ywktH/ll/XuqI090MPUccxw0leCJmVcmpr9J6Ux8eTPGnXM+/6kPQ6hJGx1rTcUWTZrarop/4rvOGSSEjsb814dVNdcW1EkZNbquMOAnzCOOhesFtnJcVZtZy10yQCc6ovZrTupBrtvy9GlLPAoXRk5G6V1OyHMYGHcUJldto5ehaDJY3X+Pe/PTi2fWz44dWRw+tjh5aHT20OnpodfQfqNUR3p8dQbL7s4zttpr2chVt8QET1Xani/UONGQDkqbx/EqNRq2KeJ2rd7vPptL9KFjOccMgTgqDR50pwX02pSNDj4IQJV7R6INSZ5sCZiWfd2lHeqkoWuWkm1Q6v2MwVGHJJZ7rWPi8NlYkAcWz9o4u3bSf+lm2sn3Orujz7VLadCr/MVU6FOlQ4gfqtMohO8IkKX/kdyzIh247G8Jqi4/rEjIIgK6aaypvUIsMiRxGLQ6gHsURFXdC2ZXIyO2CBc/XNj4r+uNwGiddBZC8uwx4fNAdxXYO2izgCJu8DuMQLqVxrtSwiNBFSBH8TTcIP9mAG5DXFdR1mVeaBXnOTV05TVelahdBgVIBB2+y38JbVV+Bk4awhTXwbAZs0rkwiZUjshuQH/eP+wd7h4dHe1LTpA59l91m2/Hv+pBlGYsQ/j/r0Goz1LYg1vMJ3aNslMGpr4Yg3lbLaD3M7+IGrbdWBuwO+HVpBCuAHvcPtxJOfCXpuzX2i5WFT5OsikwiViEdzm2uktz87HulKsCD8qg/VVFcYQru+Ti4nbrFpint1JF1jbLe43J7upAx5i2R6c3r32LuatuvuuXOrjV+mq0ZGLLIUX9pOiSI1GHCl3UvLnfbnhw9feht99Db7qG33UNvu4fedt9ubzvMj/WM61dXFyuM6z9qF5WJgsGXTDZXXxeOhU3Ok4HOq1KcOVk6q0Yg88S2a6IK8+s7H/ULwyya9ylu7PPyKt1XfeS6MWk1MAOatVGy5MXzxSBKFGWHwVXEmGkzlkL5s0qSDIsMJFE7tB3g8irDaNZiGUYfIbB02LlNT4vkenj8pB3BWOM166wwjIdSnqqWVctEzmm0VEcWGJSTHwyUrx2mXDhQF6fug1QlhZWyUTXVcb5mbN1PcOdcZ4WiCP3q9LKtb4MCjXdGRWVnVdmKplyNFXD1rsJc38vwtgqCi7nGbiLvKV7u7w+Bb/V1dOkom+63Fvzf+jmX2v9rHnQXyO2e9GVwLj7qGt5tn3WB9vMOuwCNxYOqYt0OEPfKEPdxyhO1m9OPD45XV9bfXMUwhGuRQeKQ9GMbnnfj3uiv5ePKC50NeqFX/zejcjxuYvk6NzMtvgsF/Z1O1EeojItJSog3yh1w5VWvWNZdmGOV3AFVPcQ/4pbaPvBjLd7x5qbOMzeynqtakROeCGMSqMxMioExiRSd7ZvqFhV2V0nmXiq9Owp3+eLd5MrecgmZGXpclZuzhXUv01bbYpYDZ8YaefGIaycBg8tKrNQ06/+g/9paQSmNAa9mA+68LjAV1isDEp90nqi3bSwAv9w5OS6xBgM2xNUy/izMvUK+52yBz0Pb1GEgw2opl5Hu2uqxto2pBIsjuiVMNOHKKG4BpFr9I1lsr7EgXTPHjEkdf3WdASriwRk7I92VgoPH2UalUmBtVLYjD1J1R63GULKfwj5ErlV/lGBdCyxS4YP8pfW5AEIpv7W7S0KTNHry6nOVplL4F5fpIkcwGa/ezIVRGr8OZ8a7rPOt89WK4D2dV+9HHLFlbzqtUl06mlJDqBqzsFsb3hTwLjj5+RIxVDh5BWamz4pP0qPXSmHWKwaYgkz3iBCynKqzlp7M5bhoHuVeuLPKdTDLszIbZYlfczjMhzEcwtw6oQLbHlOE1fSm4EMxpXpPUrOgRxQYJgW1ZEvmfPLtw8VHWJA17Maj3+GMhiMFbPIjnGdAZ8n+MwDmzi0tTD1nTb1nJ/cZVhc5ZZEpRYZgsYkjKI9EJlHE1JfmU7CPzSGwZRflzBSoEuRY6cEZ8w5L8LEs9A3qMWHsN55rEVHXqQK0UDzdZfmU5VIqa0ZaC+3IMMNzQwZfqoLvVa8bSIlpelOKyjmdOMz3uoouiBf6sMpPfHfFdieKatpEwJNnteLqzEHK+XVnplIMmES7HzVMoRIBxLTt4qj9HX4n1OT0wHLlEH38bNyMz/+sFBMCMWXJXgjgoXSBonYahXnkFsO3RkWgOnczXoMsIq3rsYyK6JE3wLuqIWmQSCBUI33fIG8vjvZQsG3JFHw5efdfirfHP/+XNz89ffPP/ReT8/x/Xvw+Ov7XP/44+FtLMYRuOnvsnOnBtSSn2TUQKfYO7P+avndqaTvNin9Ng18Ncn4N/gqYB56fRvA9fADu73yiptcgS/AnpCD7qUqJcH+F/8MeWu6YU2B/TpsnYjp8ee1hI9TIrZMq3X565kJyBBt3TMO5cJjdIqDIOVz8bazu+gzDgok1arBqHEgMU2zMyYB4QK8HkwXEgwD/JaeaTOaObCbt79TJSXDv0Q0wpTvqCH79JWEwtg+jrUklx9X5SQRk7B/aUgj6eywSetj3i4PGYRpecyBdV/n4J29PggvNHd5y7blH+uRiL1qEAdug7vPFTH0r9jU/2WPgml/0P03KaeIUzLoUPkL3la7Tqd8qhP/AdYbF/oiDkcQDkt6P2B+YapfTX2Ledovla6WqEvt225qaLbG3mpPCwtFwLmUtqWVbpm/fwgZT6nupDu1PZOL8BfSFWh46tqa6xyXcduHKIJ915cq7LZeu/aXl2tU/WvlMLuD2i/fouF52lba2C1X29XOtXdg7kzRwgKZPN1ovSIiifoM19Gz5VSvhfnuSm3EmmUANDXUXKLykHKPC0LLDxFhqJ79zaIu+qeDvPI97DE0LRovhJJwjc6oi2INyBP8vnt0+24tHU/hTlaP+428P8wDmViJkzvnSeXd5TjVLEr5E79xIFk3WrxGLfcTdMWPQ0ZJmsDa4ieMpIfTbQycC7ZgGpCql13jznfvdskyk1LzerAuIdlbgjELBPVMMgSMyGyo1VwszfVmwG/kI6+Py+GzVoyJxq0fc8+83Ea6ovyrV0iv8WgYmVsmYC3UCEg+KKVQcRSpLrdU3RNf+TWUbumJqapWujwBT/9mp9e0nRI3hBrkLk6TAGMoyryi4jDEEf4HcQEukoXR4rJYhHSkR+6bBpalJ9U4NPSicSSgdIcGyq21DIyJPLt4INkjs0IBqanANOCEXmFtgv9ElsGlwjrlJ5z23EjqvszCkUOi6jkwOhRWYl6BYV1PUfRy5pmLwRmyrcM4qHjh4dfWaUuiylCv9iq4nrQ78ZrBCTtrShL0hs5KL10aKuiwKPnBDqZfx+kanh7Svh7Svh7Svh7Svh7Svh7SvJbk8rnPU3L6byE1yjC5Lh+8mTenNyemi6R/ybx7ybx7ybx7ybzrKvwEmA1pbtwZjrV/LZHLf97eTBzSxfVRdtmq6iy7uGndFflwKgHCK6hhDtB0Ja3r020KUtKsgd3v6acWTQpaigv6ZFdJo/dOc/siSRFFMEyux+JdVQVtiI/SYtSg2x/u8SaSalfMMboB/f3Uc3WaC+C0IhrHYsKWbMI3/sMK+NvPUv18RB+KOo/V7leboNiDCIcV+UQf46QwUexsLwvKqR3S1SA03MKQwjRUmKplRv6Iwz7EOfsANHkrpcsFB+CzephykQx4DP8XBgGHXc5+KMV8hqccFdWuVwFz6MOKB5eoeKRkWfGk7jS0v7IaildcObwHp1JuYrR+q+aeUDP/kYuGfWCb8EwmEf2Jp8JsXBR0PqWlWKVzuwvlq+V1pL6zFzC3UU7TfdBgRZ247m7AoNmdvPA5s1MPB/bHv0LIElXhxtcSAB3r6GSUujkvs11tiLRVpIsBTBXFZqATt8mYrcFWzmB01lNaZZMMwcbpOaXCtQWm9Smw3RWcxYCAuzCVcgpAEk5EjzbWTvYFHhkrkCV4eeqTVqCTnSUwp065wV5c75eNeUJh81r1gLzF/Yt6i+aDb2z6rtXlRo4o6nnWEipMhtc1UXoF8jRU7e7NcflXk+8M43ddre2hm8v9LM5MujfDCU0XO8PJbqGlggEZrReUpbvJwavKBixiErzBvaTJcI8/Zep2K7pVJdW4ldDez3eEvqvDO1VDh+Biw5GN2tjKj+15wXZgboCn7HB37cXGz2ebxchFSxSxZ9S4urw2Qw4327LySlqsewqU3Z0v3m4PDZ3sHT/eOnlwdvHh58PTlk+P+i6dP/lVr6og9zaP+5jF0RQMH52erN0hg6PDwCTCtIj7PvndQa1ZaJp1zApqkFgGG20rf9zjvh1mDaVQXFmbjOdDsFBMoMLEBcwp0lemXbiEHvVIQjYY5CK9kjdPpUgKEvh0xVGKGcQJSEi6hGMS0WaNhk2Vo9ILuVYkGwyBgCdddN7bDPZG5nHI0wguNWLu0s53NfLXBOiJnv3e+Wipn29a2igoim9rw43AUJ3GJAvMsvs1oW8McQ5dRTo7VyGm3Td1RNbmR0ZIeKOptTSVFpcC9wFy6MJ2jYjSicB00N2F5ZemqfOWCYFqFUulftKuyVWfak86TSKxaPqUO2ziFLmKYibOYZGrM3Y0sa5GUtDQYCBb7A7OSE1Q9RrkqjREWMWTdepj/Y3P6MHOHSuBNKMleWzR7EoPds0Sgo1N7wSiJqYe5fhRDAHTAohsUTiWiyGaHGV8RLfH8orDhUgb6eDbosb4TkgqSCtKkNAtHAMMSgL3exujM7qEtGvanpKQzZe7OuKTJ4DqKemgA1IF07lQvw/6wP+pHg/uY/tZpKdjuUD1JTEIv5pvQHme6spVuTeB4IWoxeZfrReTJcy25ekI8UtzGBIgBkaQSPWgr4kuIE3U2jzh2rEA1GkjGPk95V8EwNvHNqAJyeDnQauTU7IfHrk4vTF9eYtsGTIZtpOJbK01Jam9w+c+3Elr9qNBNk7SuDANaWPo0CVcTMwHx9ZmkQjqnF3v4cKq2OHkpaRHK4MQVdLdY+LLSgRQcXavyabBjxtvh5hRjo+q5UKQ1wAtdf5J+FtVfx3s0sxw1K5FS4iNmbEVtCncdwpAuvQlC6iVNq5ARbXgeVyv6rUpH1rbAJ13ebhvMotZWMrJD4unlbdzjIBqddC9PnvLw+3oJfmNANoUA14KfgeliQpUkvEimpPrEPXGFn1krBZpPsEITPHYb43KxbIN1OcBCVU7GGZusqHlVbuYYY0zkd6YSHffghWXdwI3HzEqSVIEzJhiIQw3t6bEF6WaIsHGMWo2OHLQ9IpL5fQwmzMm7EsjYgcet7nljzNXBic6awUyH8U2VVQUAT9RM7/gthgujz5G7MEQ2DjeGLjvHlbeowCsW+AeCDf5pMSslft0CS3yq0KBnUoOY7gd9+ULy1n1BMsWbwSYVRxWHiLKtZ4D3D1XwkmJ+A7Tl45VFaeS69UGWWiBiFDtqUmBY9Nf2Hi8SBMUTxOPghZkZKKmJeFVmaTYFlGunCOHdfm0jw8XeLElJJ5dvH0uBr8RpS1cEKoSVmcQzRuU5ZdOpZgTm4dPDZ9/X1+y5qLbtlfITfbLsBqSd169PO821/YGSbKmRjU1TFg94JtUq4rYA1sNa78a2ypGbqaDG0PD4/Yfw4ofw4ofw4ofw4ofw4v9A4cWfGd272wzv1cG9lrLYLFCLnQG57fYYv4B/n1mBsCYDbS0quC0kGcTs/hco6rtXqPqJMkQ2fVd454IAb0+ujE4sXedikZbc0kIg09+iDerszb/cxEr/rJCGlWQhnOIwwWQzPK1ONhbgGrQAPMT93cY6mwmoX26jdhFASaPfLgq+LHn7QrK2P0eGqzlTVucB38+RImhfROIPFccfKo4/VBx/qDj+UHH8m6o4LtXM6nZ7/dWK+GpdC61uBS7d39DM3eiwiZK+TusOMTorSdSI3N9LY6hBNYmkrqSmTioFw2RpKqXqufFJHaa4vpFSzSZqisbhDit8vdJzuOwpE/VGg/8Ijg0Ks+pTXJQYrOiXd4wjp0ka2ZPR1J9jFjxcEBhOIAXzBjIgnb4oo5ajZVOxeREej58eHIy31y4tbkSl5FWasvuGIUbjtktNnOoBIlrh8Bx4l3yb1EiV9UZvydZ8avzvRDB4jVHv1SZi5ZW64XHuAiPli6bhR3Solljtu4iH7IQ39OmXLHJKOvDBSFWDan0PIR4YrFQaj1DDJnjNkGqKBQ0jt1e4q+KKTT9mV2aq2BpbSF0Ot4KXBwa3zfXQbnNfHO+BJDFk4mGgFmjC0pHD00fEvhR+adJb9OS5eqqGY3UQqmej4++fH0VD9f344PD5cXj47Mnz4fDF0fHz8bOtN3zTxGaTi4Q7teQXecV+HSo1J5PuSvIDm3JXWD+PbBl3mWl5XNQr4BCZGkaW26OhxRb83TQ6YmtL6kWPxF7JLOkgZw4GdwV0GhUmXP1VwEPqjGKU94GDYH1LKcHJm43lQjKnOi9udtFO9+y51J46WaxoZLKUWiSdlLWhmjKAjFduxWPv/BHquRiKFiJYAaoKpEzXIsFS/g8qLIvmEDE1UY/UOIT1UJHEmQkNMfhC2hIPjXWmjPGs6jFMt76WItbuGvbcKhxOTFnZiSFUekLS+DU6/Tr5e/c6XfSiDveQSjssvbdIAR53NXzNEWf0StoaaMJFQ4PYKil06nzofGLs1ajDOhD1fTPwNn6wgjC2lHm3++86Y8bfEONn9iSy5q5YHkbln7KPaBAOJZtNYct1YHs1iezWThka8mvWWu0f9d1ST+yO9oRT+80S2ZSfWh2coP3dBBVbZvb9i9QfyYlCWBF/4FqfJAjhm/SSi7//wUv+4CV/8JIv8ZLzOZFtcitefj1XOYP04Cp/cJU/uMofXOUPrvIHV/kSVzkXbv6zucoF6k5d5XK1r3ARwzFhv6rrKdbe41Y3sRMxjaHZpACBuPmtu80XoqP/hfj4Bt3m6wt1W/Sdt9D8g+/8wXf+4Dt/8J0/+M6/Kd85NhLUHF3Mk1fOV4vtk2eOX0UGafcigvKVzP9Q2N+GtjQlKy2Me4NVeHQujtcjLaCUTawAgkk96OLEZcEWUBcf2/BphG7UJC4mAAO6hhzAA3rN7wddwKrNxamT3TDLWDdiFjMdPJOWe5gz6dvd93A53E4QPaaRWYeliyFc0u6b92hxitCr7pjhYnc1T+w40fgbBrewaxNnKzWpc/L0xJvGtRYAQzcK8wEpNdA6ec3tyqxDI3wCRzvhzTPTkAC2J5Kn47RrambHw/H3R+MnT58/Hz45jsJn4ZOR+v7o++hAHajj50+eNRuHSGbh10Gymb6Gav29TsucxDcTRI7Rt7mlgAoxxY3FT8olNa52rA7lNCHEkkuCXzx+OpKxgb6Dg/HBs+dheDAMvz84Gj53uEKVJy5H+PD+9QpuAE9o/8Isz26x2WxRzUge58pEOGVJTIoCAeDgwSvS1kCeLJzEzWGuQk5zz+4w7xt9/iOMNumJENajQjryfhZoeXedg9atEHomTgNhwnnSM20Vd7AplTjL+qNsx3cXU4UF9BfjBiI+p+GcE1ol4RI1Yu7BQHhlCReTsXVBs9BfWiBVGcgVTZ0rC9WTTGjb9YusMzeZ6T8r3gVxUDSIxl+CX0MvD2+m3TUp30UNw/H4AWqDcFxKDdXBXwYOoststlNzwsIDuousNM0VXs9A1ySJDusBno9ZgEb6J1dVPMX9lBIKlASLKc5mt+aOT4jrbFoPTwqCVJ6Q3D/APFxivW4burigvHAQwPOKuClSD2f56tvOd4i5qlutD5Nuq2a3/+Xx8ZN9dvv+2+9/89zAf4EtWKOL8yY5L3clpjVKI2cikcLUjjCrbZqSnEijtKWLS88t2huZ00nda/Rm9rgUQli42wMiCp4ydMrzGPhqXEjdt9+wGZFJu9Y9fJCxLeyCbGptmNfMsCGJXuj31oD2PMbbGi/3WRuLoy34uWbwKApnJze95xcyfE3Mq9V6Amx2Nn85qc3t8CBB0E5/hdllA/WfHNNLAw7YyWbRo+MnHlBUp6Org4nMlyYQIjbGfIKXf+G1ta7BFWx2asTW4PH/RjxefaI2Tk4TTncWCsfkG9Z0RE8zfJdOqOPF55rbDuw6kpPrcYc0H8am6qd6zmS8WI56dcINpBf2dFZaeAh0fnIgb9eihbxwOPihvAOW5kUbYBgeCQ+1i4ylps6iMGj0xWeAuMtOjc9yHaPBy9b7mOFdwKcaanXHti43LNJhLi4EnphcrC4Vc6WtkfW4nvYyzPQo30t4k8MiQ3NZi8Tmx/r86JQxDW/ZOaLINeqaL/CbWBVyFLTZh9sfw2ysbMeRDpnVIr2pmCQ3JR2zwtG0p/cIEPoPawv+mmbgP5EF+E9g/P3adt8Hk+9Kk+83Z+39Vg29+NR1eKN1PefKCuy3a1xcPIa+vmzuDlovpOi1ruxorkybDjXXFa8n2V0ApxBWgQZYbfel4GWnDQoXNgxzFIMqA6oWnO5x1ygTKL+FkyyzNVrAXkx0eOa2mnc7FMKoawB1GY7DPN6mpv4hlQ299SO4LXG1ROT9ESdJuP+0fxA8YjT+1+D04oOgFCv1Hx5dH7JpWpfufxyczODtX9Tw73G5/+zgKXapf2rYyaO//3z1BpR2eucnNfqYPQ4kpnz/8AgmepMN40TtHz59dXj8QvAEw9Q7Fz30QnvohfbQC+2hF9rmeqF1C+q/N7nugqsBueB3ezjJSxC+qDN0mI5ADuGPe3BPTwlMkSV+4Ge82f7bdxwCKnYWfoVeN+koWnkg4TKRKpXSzey7BbklBG+tx2cbSpY27pRV+3H4AFkfc0H+sJkUPHCYxMa0izbFl6J41x6exjd5yPOVeaX80Xkt3rDZ8DdQKE0jb/xwvXIl/80JrhXM0j7qpuiETsnY8SFQeW7csnXBaeEkr/ClWmcVqoAaRbFUoEXZnXKIJN+R5jG1qN09XJCtt2gHl4BlQXPS4byNbFBHcxNNwu86+0eDtpJdc+BWGl06OqUgUXBBX+eYrkvaVzHn2cZUFZvfRdVITu8oyarIHtRT/KiNOpQpGEopgxZMv5FfWR4fea8WSAISezGhDKxreuBaD6mLkme5e5S9VdMLfXgOSd+aAwwXkl/2Pi2nUVfclVeQHiXdhlbM1NgyeTzF9jzNqUHs2guHo+jw6Mnx8tnPcYTg/MzYGBhPeiuENv8SnCCZcNI8JZ8bdmDCkgFxfYMSQvIKOmt9eCmdOXNoAG2RiOXTmAWZ5+890xpHpzbXuufHmU1Syq8dBrN8Mnmh77yw7lxygWHV+fn1GtfG8rfWnVVofN2Na5yvdefhXIK15vAebR1f86MI8yhzy5DO9OeW48W/Uep3PaFXfsNzXaB55JrvP2xBmBTKEVd4vj3DjL5bZJEWMNpvx0W3mNyIbuhhO7IchLW/0oq0BVMhx7n/bMTpnAN1z1lrb6436edPBwdEJQUyzqt3Z+9QgrtDM+U0nCGTLdS/NWDxxKkVItUK0YJ5OoPQ15SL97ml25/5U8sg5ygPOdQq1wKVJdG8xiFQ/L6VPOXewB4XTvp2bPKx1ajoz6dJX57jgkNhLslWWbpn36yZlhn05ZS+eGs8+68eYphliQrTNdE7thghb4zd9ua8oMANqziJ1hAWze29c/ji7PDg+531wAGNl2bwuwi3AYKmitZzsAwWUP9UOZqsD4yehR0s6dxQ4MdqSPkplAwndPh397uWce3vRtjzJTc7aOBS4XKual9ayVk9oO/HXWdZ1F8T3Usw6mAABmS7a+tUVRxtbKYLmOnD+VlzIspPnIWjzS3KjticDBMHN4rBVBvrmpMJu/zrFzNm5+dr4PczbK/Ez+78defeEMtFAgM1QSYvk7Sh+NbgdmBrBz5XVCSiUOVmt9iOu2CjI3ggm1Pk5EYntuMumJhqAI2rZONLdgZeMPUKOehzJzbDrpy2Xej78nl5XLlgbAfeRv/dlnF1+zhzrxiltu0ecLv73ucSUJ/WFTt1F7RGQ9c20VNW/FuWZB/jcA8TwqO4GGW3rnLyP/hXTHihX+aB+1zgaN4rrSctQ7m3sMBhhlxk/pTn+mxi8s3F97Adakuw1F/JxgYAxx7cPmcc3X+6V1jFhf23EzKLG6+63xBNxbqfFCIhCqKKYgOpFiQ3+zTGWxKE0UVABQuM9ZMiCGZhDoBTcb8chiV7Je6bKrlGJ4U+0Rf4kSP3AJQJxQvfUlVLjEsrOFoNCwK57QFjeAOjJshv5YGEkQvUypBskm0olHwMGCqqRuX9EXkl1UH47MowKCaatS2b9rPJxZt2tzAujkfOzI9XTJ1GNevz2jPzu25xFF6+QwuFqS5YryWj4dBpLfeeHYNFMWKBIut5OqFWgmQZ0kdVXvPa+GrSgll/MbH8en1c0IxJXFRKoN8JhqRw6Qod463Z2nRe/J6I40Z4mftVy8S4VFEzBdHmBdM8bEHyWt3QtjZq3ap6EkQryrLrq5kCkWNEnnw0nft8hfY+3iAfiknNN/RmfvmP16014J0A7nvOMXPCtBePTxi/r4emuXGozJZ72DCTMLfSFYPST1U0Lll37hWSBfEH5L5YUIVb0/OgwW2Yx3gT25oY/L3eVD7RFZWB4RBpLg8zo+6w2Js1wGGDIk5Hut+wuo2xwtgYtWqTt8kYKKrxOP6kCzVcwyjXMErfAbaOhIXdZH1kOEH5a+BD46SQ7rIWeml1UGuW3BD6dOWNDcLitJ3E2rzTGVZXLipKUsGYPc1BVkCkcdoK2TjJwvL+oNU3G0jF7dfYCtI0/HSNMYHX3WALho+n1dQRkNx6KNiLWJdGojq21A37frscDjP6kcuQdLPThZ4FrqtRqLtnSm/JKJZzglEhoyQrJDZnuha8W6KD2qwrQOOFbxKX4zDGJOWWw7MeKNtGk0zbDhx3GC/6IxDHPRvul2JJBtbMmD8GNM0KSHSq9waBocO5kn9oCERu7AAZlCgR5irdLYMiUWrmBAEtwAb8U3ayMTIyelA47359BMmrWyLk2qztoFHpy760MN4kumhg3RvZySFbyn18aLaEJ3/SZYAVborvplDEyafZusjBx7eKmMIJavMBkhoemxTy0Hg4pfoYbHmyVh3dp92PfXVeMEIx5najPn+Th9NiKeRbwqPMthCUYtMSl4MVoi5B4VLqMnBsDynFEpZZJNnd9eYJTJedoQulxHrlNlkPR6Qp59csg7LGsRq+LWHMnXKRbjGdwpUERzbxvS1firbLV69fnV45dLXO/FtTb9xJlwMWp4XKN4mY87eXr96vjxief8uI4UmXA1bNIrdRx5cj5sPF2cnVq7URw/NvGTE86XLAIoV1HzaImDM4SvdADM+/ZcTwpO2AoUpwTR6hYtOKBo+6eFqQCDc+cSkWNRo+eEQfWdsKpjEWvH28Bjxb2h5vziW7M4472BwadIF6gwBdJ9noY9G/C+MNq120Izi4rT9Ht/ckJB0MJ1wXrG0pXo2JVwMYT6cqijfLg9tQh0XHqeCxni+Z3wO4r4BAM/cCPinKbTmd9TfOHEx9YzRTZXmIpQ+YX2gjwN0kxjoQpKuIe3U5W2+Auy3W3ph4NYBRXHy87g6pWA0eZugGuQ7sXwHDzuyrQd00u7b4pJGDpfafBiBfAVtLrhYR6qkex29ZnG4QTTicXCVSU1UYZjEK4QfHyo9VStDyiB/jNAKCXBPabSmGtWmXAoer6waLURzpOm44iSkNGOdYQtMtbLIQsO0iDGdcAFIGCtNU5Tdw44UoBW7SHYbDBjysrfSeY7fFmyyPy8l0TZi2haz6vO3gxWmaRcP+sMISW9ezLEv6s/AGni6z0hRB2AT+aFSNuHOY9eyHgGcNaNb7QYdJ/5v0ccFwG4YwinOnQcyXg0jjbQxGdDFca+lyg1BiaRZsSEPeLyO9bhjeLZ2gVWDcC/iukCxMXFBLnVTJ2xaWcTGeW/+Ng/Qe7475CaWe+63l627A+oi/A76suiBzGrhA+cI0H/kC4vbB/HrI9eFYCn6e3ZGiR4ryRjGL42mGkXFhCYo3STWGRdvgulwAB2nK9wB2u/j1514PzA2HYE3jJIlNHNaMwnRHv1dxrr1xgliNy3tA+ZVwiVOvArIgXrFBNOKYNc7pUeR6AG0dYzztauDY3aA2jjE9Lpex/wys6QG+Aub01KuBZJ/ExpEnw+qoontiTt7+CoiTmVeDyC6LjeNNhv3skyrvfwXMycz9RbluTsvOz45TdoayTeUVZeS4P40mYZqqpMdYvPz53S/B5euTf38VXF6dXH247NnawPYdDmpeN9pYZmhFbzOva10CaFsCl5zWieiRGodVUuqfFynuVCXfSRXfEICU9O31itPRso/ehEUJJIeZ9Y+XQuXE4W9EOW+0r6uDhFXWl4NU+cnDG0AUg3CNAy8H7sOH87PlwMlQcbTR0HYZczlwl/zYebQcQlDqyAO4YRRKL8g4RcMyzEHGZRZjJLzofP+dDlfVIL8GWH6E51ZDPMuKuMaSvpwWeUh96TWXsAj69yhfOUu4yBa5gdE5sV2MC4lg/X6p8VaPlMOUGLuSJJxfr7cbZi1fZS/ut65X8Nt6OxRn1/xSn26oDe9RaWJtfQq6TMJbdX3+7voSn1gLtKrYHGxu2psDls3texn8UxW94G2GuSqnEr8MWpwF/D0/uBL0ZUHfzUomm4Cdq1qbxmaiDPgdd2p85vdkW0TgEinjEr7RyFxKDQ6QneLUgdDitB2kJLzpi76/ScHakatgBsATT3H9g5rEqWa8j3sUgIP7fTdRaRN0tE4uhT4n3ge8pV8vErKZwG3MFUkSkVxhKsOjC813kTNd4twLtvymjKM+Zk8vZ7lftOU/XZ2fUYb2np6DUnsLrgK/GKxcYW+LRTkBn31YCBysDWEyA4S7C1Ug6mTi658ADpB6ymXY05dER1DW7yAD5Sv5YRWQwCipiGffaW2/CRK0yXT21qQino3LqBccmNQ0BmU5oFNVFH59DAtrqT6Vn+PxpBHXAXYxa/wqWLQMZ00sWki/AhottE2tn00DX6rwqxTtHpFNMyajg6n0dU1th8L++du3785+uH7z6ur9+emlBo9BMOnK2KwUj/rIKarNWcm+YaOw1Qpk0rXylAVlOIGfP5bEBR5oaj+FJd6wvBDWx5gDGNyffl2rQxQXhI5rgmyjIQIMNiy8hkq9EDP3glQh64y5Jk/v9Ra8yCs9Vi5UX8Vv/PkQbivjatH864G7WSnN+IRrqGs4h9f3AzcA/kp4XYDPaNoBHrW3Z8WUW8KEmW8xOOysKLry36yceIuYkBkXg8T+h6Ijd8zKebeICplxMUjsUCg68rCsnHeLqJAZF+jE2egjPBFG5OHe5I2qx1xn3i1hw590CWDao37dTRjHOjEaLXBsE0v+zE0BXEqeofT5pVJ4UU2naDaFqcmZ9uOH16+Di/fvTl9dXr4+v7xyCvjrKe/pT5PSAR1UT2iCtijZ/Xqxqa5RTRT/41UU/TIgh3Mx6j0igx1WoK6m6eOeU5GjcNrF87MolbOKQg2o0yxVC5cmiXZfa3EyffDoVP6QBS6E17Ro/QrAUkuWRx+4McsSME36djcZ49r4PKa+gekNN5RlTdTNHAfAi0mWRIuBBHmeH+7CtlqlXEBJGwl4PgM9z7sGaPHmS6cE1sH52WAtJMTPNvUReX0pWJ5lZQNA6bgCrHk6lF5AXwRg126Pz6ezdJxt0EZmUsRWAPb/ADXVglc="
 }
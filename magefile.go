@@ -75,7 +75,7 @@ func Package() {
 
 	devtools.UseCommunityBeatPackaging()
 
-	mg.Deps(Update)
+	mg.Deps(Update, Dashboards)
 	mg.Deps(CrossBuild, CrossBuildGoDaemon)
 	mg.SerialDeps(devtools.Package, TestPackages)
 }
@@ -90,6 +90,12 @@ func Update() error {
 	return sh.Run("make", "update")
 }
 
+// Dashboards collects the Kibana dashboards of _meta/kibana and generates the
+// index pattern into build/kibana, the dashboards of the packages.
+func Dashboards() error {
+	return devtools.KibanaDashboards()
+}
+
 // Fields generates a fields.yml for the Beat.
 func Fields() error {
 	return devtools.GenerateFieldsYAML()
@@ -0,0 +1,190 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("accounts", newAccounts)
+}
+
+const (
+	// accounts sources
+	accountsSourceAuto              = "auto"
+	accountsSourcePerformanceSchema = "performance_schema"
+	accountsSourceUserstat          = "userstat"
+)
+
+// accountCounters are the account fields that also have a per second rate
+var accountCounters = []string{"connections.total", "connections.denied", "rows.read", "rows.changed", "rows.sent"}
+
+type accountsConfig struct {
+	Source       string   `config:"source"`
+	ExcludeUsers []string `config:"exclude_users"`
+}
+
+// accounts reports the connections and the rows read and changed per account
+// under mysql.account, from performance_schema (per user and host) or from the
+// Percona/MariaDB user statistics (per user, with the denied connections)
+type accounts struct {
+	config accountsConfig
+	rates  *rateTracker
+}
+
+func newAccounts(cfg *common.Config) (Module, error) {
+	c := accountsConfig{
+		Source: accountsSourceAuto,
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	switch c.Source {
+	case accountsSourceAuto, accountsSourcePerformanceSchema, accountsSourceUserstat:
+	default:
+		return nil, fmt.Errorf("unknown accounts source: %v", c.Source)
+	}
+
+	if err := checkPatterns(c.ExcludeUsers); err != nil {
+		return nil, err
+	}
+
+	return &accounts{config: c, rates: newRateTracker()}, nil
+}
+
+// Fetch returns one event per account
+func (m *accounts) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+
+	source := m.config.Source
+	if source == accountsSourceAuto {
+		variables, err := QueryVariables(ctx, db, "SHOW GLOBAL VARIABLES LIKE 'userstat'")
+		if err != nil {
+			return nil, err
+		}
+
+		source = accountsSourcePerformanceSchema
+		if variables["userstat"] == "ON" {
+			source = accountsSourceUserstat
+		}
+	}
+
+	var accounts []common.MapStr
+	var err error
+	if source == accountsSourceUserstat {
+		accounts, err = m.fetchUserstat(ctx, db)
+	} else {
+		accounts, err = m.fetchPerformanceSchema(ctx, db)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var events []common.MapStr
+	tracked := map[string]bool{}
+	for _, account := range accounts {
+		user, _ := account["user"].(string)
+		if matchAny(m.config.ExcludeUsers, user) {
+			continue
+		}
+
+		key := fmt.Sprintf("%v@%v", user, account["host"])
+		for _, counter := range accountCounters {
+			value, err := account.GetValue(counter)
+			if err != nil {
+				continue
+			}
+
+			// The sums beyond the int64 range are parsed as float64
+			var n float64
+			switch value := value.(type) {
+			case int64:
+				n = float64(value)
+			case float64:
+				n = value
+			default:
+				continue
+			}
+
+			tracked[key+"/"+counter] = true
+			if rate, ok := m.rates.rate(key+"/"+counter, n, now); ok {
+				account.Put(counter+rateSuffix, rate)
+			}
+		}
+		account["source"] = source
+
+		events = append(events, common.MapStr{"mysql": common.MapStr{"account": account}})
+	}
+
+	// The accounts that are gone (short-lived client hosts) are forgotten
+	m.rates.retain(tracked)
+
+	return events, nil
+}
+
+// fetchPerformanceSchema reads the connections of performance_schema.accounts
+// and the rows of the statements summary by account. The background threads
+// have no user and are skipped.
+func (m *accounts) fetchPerformanceSchema(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, "SELECT a.USER, a.HOST, a.CURRENT_CONNECTIONS, a.TOTAL_CONNECTIONS, "+
+		"SUM(s.SUM_ROWS_EXAMINED) AS ROWS_READ, SUM(s.SUM_ROWS_AFFECTED) AS ROWS_CHANGED, SUM(s.SUM_ROWS_SENT) AS ROWS_SENT "+
+		"FROM performance_schema.accounts a "+
+		"LEFT JOIN performance_schema.events_statements_summary_by_account_by_event_name s ON s.USER = a.USER AND s.HOST = a.HOST "+
+		"WHERE a.USER IS NOT NULL "+
+		"GROUP BY a.USER, a.HOST, a.CURRENT_CONNECTIONS, a.TOTAL_CONNECTIONS")
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []common.MapStr
+	for _, row := range rows {
+		accounts = append(accounts, common.MapStr{
+			"user": row["USER"],
+			"host": row["HOST"],
+			"connections": common.MapStr{
+				"current": parseInt(row["CURRENT_CONNECTIONS"]),
+				"total":   parseInt(row["TOTAL_CONNECTIONS"]),
+			},
+			"rows": common.MapStr{
+				"read":    parseInt(row["ROWS_READ"]),
+				"changed": parseInt(row["ROWS_CHANGED"]),
+				"sent":    parseInt(row["ROWS_SENT"]),
+			},
+		})
+	}
+
+	return accounts, nil
+}
+
+// fetchUserstat reads information_schema.USER_STATISTICS, which requires the
+// userstat variable
+func (m *accounts) fetchUserstat(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, "SELECT * FROM information_schema.USER_STATISTICS")
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []common.MapStr
+	for _, row := range rows {
+		accounts = append(accounts, common.MapStr{
+			"user": row["USER"],
+			"connections": common.MapStr{
+				"current": parseInt(row["CONCURRENT_CONNECTIONS"]),
+				"total":   parseInt(row["TOTAL_CONNECTIONS"]),
+				"denied":  parseInt(row["DENIED_CONNECTIONS"]),
+			},
+			"rows": common.MapStr{
+				"read":    parseInt(row["TABLE_ROWS_READ"]),
+				"changed": parseInt(row["ROWS_UPDATED"]),
+				"sent":    parseInt(row["ROWS_FETCHED"]),
+			},
+		})
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,69 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("binlog", newBinlog)
+}
+
+// binlog reports the binary logs total size and file count, the current
+// position and the executed GTID set under mysql.binlog, from SHOW BINARY LOGS
+// and SHOW MASTER STATUS. It emits no event when the binary log is disabled.
+type binlog struct{}
+
+func newBinlog(cfg *common.Config) (Module, error) {
+	return &binlog{}, nil
+}
+
+// Fetch returns a single event with the binary logs state
+func (m *binlog) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	variables, err := QueryVariables(ctx, db, "SHOW GLOBAL VARIABLES LIKE 'log_bin'")
+	if err != nil {
+		return nil, err
+	}
+	if variables["log_bin"] != "ON" {
+		return nil, nil
+	}
+
+	logs, err := QueryRows(ctx, db, "SHOW BINARY LOGS")
+	if err != nil {
+		return nil, err
+	}
+
+	var size int64
+	for _, log := range logs {
+		size += parseInt(log["File_size"])
+	}
+
+	fields := common.MapStr{
+		"files.count": len(logs),
+		"files.size":  size,
+	}
+	if len(logs) > 0 {
+		fields["files.first"] = logs[0]["Log_name"]
+	}
+
+	status, err := QueryRows(ctx, db, "SHOW MASTER STATUS")
+	if err != nil {
+		return nil, err
+	}
+	if len(status) > 0 {
+		fields["current.file"] = status[0]["File"]
+		putInt(fields, "current.position", status[0]["Position"])
+		fields["do_db"] = status[0]["Binlog_Do_DB"]
+		fields["ignore_db"] = status[0]["Binlog_Ignore_DB"]
+		fields["gtid.executed"] = cleanGTIDSet(status[0]["Executed_Gtid_Set"])
+	}
+
+	binlogFields := common.MapStr{}
+	for key, value := range fields {
+		binlogFields.Put(key, value)
+	}
+
+	return []common.MapStr{{"mysql": common.MapStr{"binlog": binlogFields}}}, nil
+}
@@ -0,0 +1,81 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("buffer_pool", newBufferPool)
+}
+
+// bufferPoolFields maps the INNODB_BUFFER_POOL_STATS columns to their fields
+var bufferPoolFields = map[string]statusField{
+	"POOL_SIZE":                   {name: "pages.total"},
+	"FREE_BUFFERS":                {name: "pages.free"},
+	"DATABASE_PAGES":              {name: "pages.data"},
+	"OLD_DATABASE_PAGES":          {name: "pages.old"},
+	"MODIFIED_DATABASE_PAGES":     {name: "pages.dirty"},
+	"PENDING_READS":               {name: "pending.reads"},
+	"PENDING_FLUSH_LRU":           {name: "pending.flush_lru"},
+	"PENDING_FLUSH_LIST":          {name: "pending.flush_list"},
+	"NUMBER_PAGES_READ":           {name: "pages.read", counter: true},
+	"NUMBER_PAGES_CREATED":        {name: "pages.created", counter: true},
+	"NUMBER_PAGES_WRITTEN":        {name: "pages.written", counter: true},
+	"NUMBER_PAGES_GET":            {name: "pages.get", counter: true},
+	"NUMBER_PAGES_READ_AHEAD":     {name: "read_ahead.pages", counter: true},
+	"NUMBER_READ_AHEAD_EVICTED":   {name: "read_ahead.evicted", counter: true},
+	"NUMBER_PAGES_MADE_YOUNG":     {name: "pages.made_young", counter: true},
+	"NUMBER_PAGES_NOT_MADE_YOUNG": {name: "pages.not_made_young", counter: true},
+	"LRU_IO_TOTAL":                {name: "lru.io", counter: true},
+	"HIT_RATE":                    {name: "hit_rate"},
+}
+
+// bufferPool reports the statistics of every InnoDB buffer pool instance from
+// information_schema.INNODB_BUFFER_POOL_STATS under mysql.buffer_pool, with the
+// per second rates of the counters. The hit rate is per thousand page gets.
+type bufferPool struct {
+	rates *rateTracker
+}
+
+func newBufferPool(cfg *common.Config) (Module, error) {
+	return &bufferPool{rates: newRateTracker()}, nil
+}
+
+// Fetch returns one event per buffer pool instance
+func (m *bufferPool) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+	rows, err := QueryRows(ctx, db, "SELECT * FROM information_schema.INNODB_BUFFER_POOL_STATS")
+	if err != nil {
+		return nil, err
+	}
+
+	var events []common.MapStr
+	for _, row := range rows {
+		pool := row["POOL_ID"]
+		fields := common.MapStr{"pool_id": parseInt(pool)}
+
+		for column, field := range bufferPoolFields {
+			value, exists := row[column]
+			if !exists {
+				continue
+			}
+
+			n := parseInt(value)
+			fields.Put(field.name, n)
+			if field.counter {
+				// The rates are tracked per pool instance
+				if rate, ok := m.rates.rate(pool+"/"+field.name, float64(n), now); ok {
+					fields.Put(field.name+rateSuffix, rate)
+				}
+			}
+		}
+
+		events = append(events, common.MapStr{"mysql": common.MapStr{"buffer_pool": fields}})
+	}
+
+	return events, nil
+}
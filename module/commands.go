@@ -0,0 +1,97 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("commands", newCommands)
+}
+
+// commandTotals are the derived per second rates and the counters they sum
+var commandTotals = map[string][]string{
+	"queries":      {"Queries"},
+	"questions":    {"Questions"},
+	"reads":        {"Com_select"},
+	"writes":       {"Com_insert", "Com_insert_select", "Com_update", "Com_update_multi", "Com_delete", "Com_delete_multi", "Com_replace", "Com_replace_select", "Com_load"},
+	"transactions": {"Com_commit", "Com_rollback"},
+}
+
+type commandsConfig struct {
+	Include []string `config:"include"`
+}
+
+// commands reports the per second rates of the Com_* and Handler_* counters
+// under mysql.commands.com and mysql.commands.handler, and the queries,
+// reads, writes and transactions per second under mysql.commands.rate
+type commands struct {
+	config commandsConfig
+	rates  *rateTracker
+}
+
+func newCommands(cfg *common.Config) (Module, error) {
+	c := commandsConfig{
+		Include: []string{
+			"Com_select", "Com_insert", "Com_insert_select", "Com_update", "Com_update_multi", "Com_delete",
+			"Com_delete_multi", "Com_replace", "Com_replace_select", "Com_load", "Com_commit", "Com_rollback",
+			"Com_begin", "Com_call_procedure", "Com_stmt_execute", "Com_stmt_prepare", "Com_set_option",
+			"Handler_*",
+		},
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	if err := checkPatterns(c.Include); err != nil {
+		return nil, err
+	}
+
+	return &commands{config: c, rates: newRateTracker()}, nil
+}
+
+// Fetch returns a single event with the counters and their rates, the rates
+// are missing on the first fetch
+func (m *commands) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+	variables, err := QueryVariables(ctx, db, "SHOW GLOBAL STATUS WHERE Variable_name LIKE 'Com\\_%' OR Variable_name LIKE 'Handler\\_%' "+
+		"OR Variable_name IN ('Queries', 'Questions')")
+	if err != nil {
+		return nil, err
+	}
+
+	fields := common.MapStr{}
+	for variable, value := range variables {
+		if !matchAny(m.config.Include, variable) {
+			continue
+		}
+
+		// Com_select is reported as com.select, Handler_read_key as handler.read_key
+		parts := strings.SplitN(strings.ToLower(variable), "_", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		name := parts[0] + "." + parts[1]
+		n := parseInt(value)
+		fields.Put(name, n)
+		m.rates.putRate(fields, name, float64(n), now)
+	}
+
+	for total, counters := range commandTotals {
+		var sum int64
+		for _, counter := range counters {
+			sum += parseInt(variables[counter])
+		}
+
+		if rate, ok := m.rates.rate("rate/"+total, float64(sum), now); ok {
+			fields.Put("rate."+total+rateSuffix, rate)
+		}
+	}
+
+	return []common.MapStr{{"mysql": common.MapStr{"commands": fields}}}, nil
+}
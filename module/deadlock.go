@@ -0,0 +1,230 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("deadlock", newDeadlock)
+}
+
+var (
+	deadlockTransactionRegexp = regexp.MustCompile(`^\*\*\* \((\d+)\) TRANSACTION:`)
+	deadlockHoldsRegexp       = regexp.MustCompile(`^\*\*\* \((\d+)\) HOLDS THE LOCK\(S\):`)
+	deadlockWaitingRegexp     = regexp.MustCompile(`^\*\*\* \((\d+)\) WAITING FOR THIS LOCK TO BE GRANTED:`)
+	deadlockRollbackRegexp    = regexp.MustCompile(`^\*\*\* WE ROLL BACK TRANSACTION \((\d+)\)`)
+	deadlockTrxRegexp         = regexp.MustCompile(`^TRANSACTION (\d+), ACTIVE (\d+) sec`)
+	deadlockThreadRegexp      = regexp.MustCompile(`^MySQL thread id (\d+), OS thread handle \S+, query id \d+ (\S+) (\S+)`)
+	deadlockTableRegexp       = regexp.MustCompile(`table (\S+)`)
+	deadlockIndexRegexp       = regexp.MustCompile(`index (\S+) of table`)
+	deadlockModeRegexp        = regexp.MustCompile(`lock[_ ]mode (\S+)`)
+)
+
+type deadlockConfig struct {
+	ReportExisting bool `config:"report_existing"`
+}
+
+// deadlockTransaction is a transaction involved in a deadlock
+type deadlockTransaction struct {
+	number  int
+	id      string
+	active  int64
+	thread  int64
+	host    string
+	user    string
+	query   []string
+	holds   string
+	waiting string
+}
+
+// deadlockInfo is the latest detected deadlock of SHOW ENGINE INNODB STATUS
+type deadlockInfo struct {
+	time         string
+	victim       int
+	transactions []*deadlockTransaction
+}
+
+// deadlock reports every new deadlock detected by InnoDB with its transactions,
+// their statements and locks, under mysql.deadlock. InnoDB only keeps the
+// latest deadlock, the deadlocks between two fetches are missed.
+type deadlock struct {
+	config deadlockConfig
+	last   string
+	fetch  int
+}
+
+func newDeadlock(cfg *common.Config) (Module, error) {
+	c := deadlockConfig{}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	return &deadlock{config: c}, nil
+}
+
+// Fetch returns an event when the latest deadlock is new. The deadlock found
+// on the first fetch happened before the beat started and is only reported
+// with report_existing.
+func (m *deadlock) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, "SHOW ENGINE INNODB STATUS")
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	m.fetch++
+	info, found := parseDeadlock(rows[0]["Status"])
+	if !found || info.time == m.last {
+		return nil, nil
+	}
+
+	m.last = info.time
+	if m.fetch == 1 && !m.config.ReportExisting {
+		return nil, nil
+	}
+
+	return []common.MapStr{{"mysql": common.MapStr{"deadlock": info.fields()}}}, nil
+}
+
+// parseDeadlock parses the LATEST DETECTED DEADLOCK section of the InnoDB status
+func parseDeadlock(status string) (*deadlockInfo, bool) {
+	lines := strings.Split(status, "\n")
+
+	start := -1
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "LATEST DETECTED DEADLOCK" {
+			start = i + 2
+			break
+		}
+	}
+	if start < 0 || start >= len(lines) {
+		return nil, false
+	}
+
+	info := &deadlockInfo{time: strings.TrimSpace(lines[start])}
+
+	var trx *deadlockTransaction
+	var lock *string
+	inQuery := false
+	for _, line := range lines[start+1:] {
+		line = strings.TrimRight(line, "\r")
+
+		// The section ends with the dashes line of the next section title
+		if line != "" && strings.Trim(line, "-") == "" {
+			break
+		}
+
+		if match := deadlockTransactionRegexp.FindStringSubmatch(line); match != nil {
+			number, _ := strconv.Atoi(match[1])
+			trx = &deadlockTransaction{number: number}
+			info.transactions = append(info.transactions, trx)
+			lock, inQuery = nil, false
+			continue
+		}
+
+		if match := deadlockRollbackRegexp.FindStringSubmatch(line); match != nil {
+			info.victim, _ = strconv.Atoi(match[1])
+			break
+		}
+
+		if trx == nil {
+			continue
+		}
+
+		if deadlockHoldsRegexp.MatchString(line) {
+			lock, inQuery = &trx.holds, false
+			continue
+		}
+		if deadlockWaitingRegexp.MatchString(line) {
+			lock, inQuery = &trx.waiting, false
+			continue
+		}
+
+		switch {
+		case lock != nil:
+			// The lock description is followed by the record dump
+			if *lock == "" {
+				*lock = line
+			}
+		case inQuery:
+			trx.query = append(trx.query, line)
+		default:
+			if match := deadlockTrxRegexp.FindStringSubmatch(line); match != nil {
+				trx.id = match[1]
+				trx.active = parseInt(match[2])
+			} else if match := deadlockThreadRegexp.FindStringSubmatch(line); match != nil {
+				trx.thread = parseInt(match[1])
+				trx.host, trx.user = match[2], match[3]
+				// The statement follows the thread line
+				inQuery = true
+			}
+		}
+	}
+
+	return info, len(info.transactions) > 0
+}
+
+// fields converts the deadlock to event fields
+func (d *deadlockInfo) fields() common.MapStr {
+	var transactions []common.MapStr
+	for _, trx := range d.transactions {
+		fields := common.MapStr{
+			"number":         trx.number,
+			"id":             trx.id,
+			"active_seconds": trx.active,
+			"thread_id":      trx.thread,
+			"host":           trx.host,
+			"user":           trx.user,
+			"query":          strings.TrimSpace(strings.Join(trx.query, "\n")),
+			"victim":         trx.number == d.victim,
+		}
+
+		if trx.holds != "" {
+			fields["holds_lock"] = lockFields(trx.holds)
+		}
+		if trx.waiting != "" {
+			fields["waiting_lock"] = lockFields(trx.waiting)
+		}
+
+		transactions = append(transactions, fields)
+	}
+
+	return common.MapStr{
+		"time":         d.time,
+		"victim":       d.victim,
+		"transactions": transactions,
+	}
+}
+
+// lockFields parses the description of a lock, such as "RECORD LOCKS space id
+// 2 page no 4 n bits 72 index PRIMARY of table `test`.`t` trx id 1234
+// lock_mode X locks rec but not gap waiting"
+func lockFields(description string) common.MapStr {
+	fields := common.MapStr{"description": description}
+
+	if strings.HasPrefix(description, "RECORD LOCKS") {
+		fields["type"] = "record"
+	} else if strings.HasPrefix(description, "TABLE LOCK") {
+		fields["type"] = "table"
+	}
+
+	if match := deadlockTableRegexp.FindStringSubmatch(description); match != nil {
+		fields["table"] = strings.Replace(match[1], "`", "", -1)
+	}
+	if match := deadlockIndexRegexp.FindStringSubmatch(description); match != nil {
+		fields["index"] = strings.Replace(match[1], "`", "", -1)
+	}
+	if match := deadlockModeRegexp.FindStringSubmatch(description); match != nil {
+		fields["mode"] = match[1]
+	}
+
+	return fields
+}
@@ -0,0 +1,77 @@
+// +build !integration
+
+package module
+
+import (
+	"testing"
+)
+
+const deadlockStatus = `
+=====================================
+2020-03-04 10:12:31 0x7f1c8c1f6700 INNODB MONITOR OUTPUT
+=====================================
+------------------------
+LATEST DETECTED DEADLOCK
+------------------------
+2020-03-04 10:11:58 0x7f1c8c1b5700
+*** (1) TRANSACTION:
+TRANSACTION 2108, ACTIVE 12 sec starting index read
+mysql tables in use 1, locked 1
+LOCK WAIT 3 lock struct(s), heap size 1136, 2 row lock(s)
+MySQL thread id 9, OS thread handle 139759560681216, query id 46 localhost app updating
+UPDATE accounts SET balance = balance - 10
+WHERE id = 2
+*** (1) WAITING FOR THIS LOCK TO BE GRANTED:
+RECORD LOCKS space id 2 page no 4 n bits 72 index PRIMARY of table ` + "`bank`.`accounts`" + ` trx id 2108 lock_mode X locks rec but not gap waiting
+Record lock, heap no 3 PHYSICAL RECORD: n_fields 4; compact format; info bits 0
+*** (2) TRANSACTION:
+TRANSACTION 2109, ACTIVE 8 sec starting index read
+mysql tables in use 1, locked 1
+3 lock struct(s), heap size 1136, 2 row lock(s)
+MySQL thread id 10, OS thread handle 139759560410880, query id 47 10.0.0.5 app updating
+UPDATE accounts SET balance = balance + 10 WHERE id = 1
+*** (2) HOLDS THE LOCK(S):
+RECORD LOCKS space id 2 page no 4 n bits 72 index PRIMARY of table ` + "`bank`.`accounts`" + ` trx id 2109 lock_mode X locks rec but not gap
+*** (2) WAITING FOR THIS LOCK TO BE GRANTED:
+RECORD LOCKS space id 2 page no 4 n bits 72 index PRIMARY of table ` + "`bank`.`accounts`" + ` trx id 2109 lock_mode X locks rec but not gap waiting
+*** WE ROLL BACK TRANSACTION (2)
+------------
+TRANSACTIONS
+------------
+Trx id counter 2110
+`
+
+func TestParseDeadlock(t *testing.T) {
+	info, found := parseDeadlock(deadlockStatus)
+	if !found {
+		t.Fatal("expected a deadlock")
+	}
+
+	if info.time != "2020-03-04 10:11:58 0x7f1c8c1b5700" || info.victim != 2 || len(info.transactions) != 2 {
+		t.Fatalf("unexpected deadlock: %+v", info)
+	}
+
+	first := info.transactions[0]
+	if first.id != "2108" || first.active != 12 || first.thread != 9 || first.host != "localhost" || first.user != "app" {
+		t.Fatalf("unexpected first transaction: %+v", first)
+	}
+	if len(first.query) != 2 || first.holds != "" {
+		t.Fatalf("unexpected first transaction statement or locks: %+v", first)
+	}
+
+	lock := lockFields(first.waiting)
+	if lock["table"] != "bank.accounts" || lock["index"] != "PRIMARY" || lock["mode"] != "X" || lock["type"] != "record" {
+		t.Fatalf("unexpected lock fields: %v", lock)
+	}
+
+	second := info.transactions[1]
+	if second.host != "10.0.0.5" || second.holds == "" || second.waiting == "" {
+		t.Fatalf("unexpected second transaction: %+v", second)
+	}
+}
+
+func TestParseDeadlockNone(t *testing.T) {
+	if _, found := parseDeadlock("------------\nTRANSACTIONS\n------------\n"); found {
+		t.Fatal("expected no deadlock")
+	}
+}
@@ -0,0 +1,183 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("statement_digest", newStatementDigest)
+}
+
+const (
+	// statement_digest order_by values
+	digestOrderByLatency      = "latency"
+	digestOrderByRowsExamined = "rows_examined"
+)
+
+type digestConfig struct {
+	Top     int    `config:"top" validate:"min=1"`
+	OrderBy string `config:"order_by"`
+}
+
+// digestCounters are the cumulated counters of a statement digest
+type digestCounters struct {
+	count, latency, rowsExamined, rowsSent, errors, noIndexUsed uint64
+}
+
+// digestStats are the counters of a digest over the last interval
+type digestStats struct {
+	schema, digest, text string
+	interval, total      digestCounters
+}
+
+// statementDigest emits the top statements of the last interval from
+// performance_schema.events_statements_summary_by_digest, ordered by total
+// latency or rows examined, under mysql.statement
+type statementDigest struct {
+	config   digestConfig
+	previous map[string]digestCounters
+}
+
+func newStatementDigest(cfg *common.Config) (Module, error) {
+	c := digestConfig{
+		Top:     10,
+		OrderBy: digestOrderByLatency,
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	switch c.OrderBy {
+	case digestOrderByLatency, digestOrderByRowsExamined:
+	default:
+		return nil, fmt.Errorf("unknown order_by: %v", c.OrderBy)
+	}
+
+	return &statementDigest{config: c}, nil
+}
+
+// Fetch returns one event per top statement, there are no events on the first
+// fetch since the interval deltas can't be computed yet
+func (m *statementDigest) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, "SELECT SCHEMA_NAME, DIGEST, DIGEST_TEXT, COUNT_STAR, SUM_TIMER_WAIT, SUM_ROWS_EXAMINED, "+
+		"SUM_ROWS_SENT, SUM_ERRORS, SUM_NO_INDEX_USED FROM performance_schema.events_statements_summary_by_digest")
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]digestCounters, len(rows))
+	var stats []digestStats
+	for _, row := range rows {
+		key := row["SCHEMA_NAME"] + "/" + row["DIGEST"]
+		counters := digestCounters{
+			count:        parseUint(row["COUNT_STAR"]),
+			latency:      parseUint(row["SUM_TIMER_WAIT"]),
+			rowsExamined: parseUint(row["SUM_ROWS_EXAMINED"]),
+			rowsSent:     parseUint(row["SUM_ROWS_SENT"]),
+			errors:       parseUint(row["SUM_ERRORS"]),
+			noIndexUsed:  parseUint(row["SUM_NO_INDEX_USED"]),
+		}
+		current[key] = counters
+
+		if m.previous == nil {
+			continue
+		}
+
+		// A digest that is new or was reset counts from zero
+		previous, exists := m.previous[key]
+		if !exists || counters.count < previous.count || counters.latency < previous.latency {
+			previous = digestCounters{}
+		}
+
+		interval := digestCounters{
+			count:        counters.count - previous.count,
+			latency:      counters.latency - previous.latency,
+			rowsExamined: counters.rowsExamined - previous.rowsExamined,
+			rowsSent:     counters.rowsSent - previous.rowsSent,
+			errors:       counters.errors - previous.errors,
+			noIndexUsed:  counters.noIndexUsed - previous.noIndexUsed,
+		}
+		if interval.count == 0 {
+			continue
+		}
+
+		stats = append(stats, digestStats{
+			schema:   row["SCHEMA_NAME"],
+			digest:   row["DIGEST"],
+			text:     row["DIGEST_TEXT"],
+			interval: interval,
+			total:    counters,
+		})
+	}
+
+	isFirst := m.previous == nil
+	m.previous = current
+	if isFirst {
+		return nil, nil
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if m.config.OrderBy == digestOrderByRowsExamined {
+			return stats[i].interval.rowsExamined > stats[j].interval.rowsExamined
+		}
+		return stats[i].interval.latency > stats[j].interval.latency
+	})
+
+	if len(stats) > m.config.Top {
+		stats = stats[:m.config.Top]
+	}
+
+	var events []common.MapStr
+	for i, s := range stats {
+		events = append(events, common.MapStr{
+			"mysql": common.MapStr{
+				"statement": common.MapStr{
+					"rank":     i + 1,
+					"schema":   s.schema,
+					"digest":   s.digest,
+					"text":     s.text,
+					"interval": s.interval.fields(),
+					"total":    s.total.fields(),
+				},
+			},
+		})
+	}
+
+	return events, nil
+}
+
+// fields converts the counters to event fields, the timer waits are
+// converted from picoseconds to microseconds
+func (c digestCounters) fields() common.MapStr {
+	fields := common.MapStr{
+		"count":          c.count,
+		"latency.sum_us": c.latency / 1000000,
+		"latency.avg_us": uint64(0),
+		"rows_examined":  c.rowsExamined,
+		"rows_sent":      c.rowsSent,
+		"errors":         c.errors,
+		"no_index_used":  c.noIndexUsed,
+	}
+	if c.count > 0 {
+		fields["latency.avg_us"] = c.latency / c.count / 1000000
+	}
+
+	result := common.MapStr{}
+	for key, value := range fields {
+		result.Put(key, value)
+	}
+
+	return result
+}
+
+// parseUint parses an unsigned integer value, invalid values are 0
+func parseUint(value string) uint64 {
+	n, _ := strconv.ParseUint(value, 10, 64)
+	return n
+}
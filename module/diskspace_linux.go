@@ -0,0 +1,15 @@
+package module
+
+import (
+	"syscall"
+)
+
+// diskSpace returns the free and total bytes of the file system of path
+func diskSpace(path string) (free, total uint64, err error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, err
+	}
+
+	return stat.Bavail * uint64(stat.Bsize), stat.Blocks * uint64(stat.Bsize), nil
+}
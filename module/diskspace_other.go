@@ -0,0 +1,12 @@
+// +build !linux
+
+package module
+
+import (
+	"errors"
+)
+
+// diskSpace isn't supported on this platform
+func diskSpace(path string) (free, total uint64, err error) {
+	return 0, 0, errors.New("disk space is only supported on linux")
+}
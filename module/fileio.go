@@ -0,0 +1,109 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("file_io", newFileIO)
+}
+
+// fileTypes maps the file I/O instruments to the file types they are reported
+// under, the other instruments are reported as other
+var fileTypes = map[string]string{
+	"wait/io/file/innodb/innodb_log_file":  "redo_log",
+	"wait/io/file/innodb/innodb_data_file": "data",
+	"wait/io/file/innodb/innodb_temp_file": "temp",
+	"wait/io/file/sql/binlog":              "binlog",
+	"wait/io/file/sql/binlog_index":        "binlog",
+	"wait/io/file/sql/relaylog":            "relay_log",
+	"wait/io/file/sql/relaylog_index":      "relay_log",
+	"wait/io/file/myisam/dfile":            "data",
+	"wait/io/file/myisam/kfile":            "data",
+}
+
+// fileIOColumns maps the file_summary_by_instance columns to their fields
+var fileIOColumns = map[string]string{
+	"COUNT_READ":                "read.count",
+	"SUM_NUMBER_OF_BYTES_READ":  "read.bytes",
+	"SUM_TIMER_READ":            "read.latency_us",
+	"COUNT_WRITE":               "write.count",
+	"SUM_NUMBER_OF_BYTES_WRITE": "write.bytes",
+	"SUM_TIMER_WRITE":           "write.latency_us",
+}
+
+// fileIO reports the reads and writes of the redo log, binary log, relay log
+// and data files from performance_schema.file_summary_by_instance, aggregated
+// by file type, under mysql.file_io with the per second rates
+type fileIO struct {
+	rates *rateTracker
+}
+
+func newFileIO(cfg *common.Config) (Module, error) {
+	return &fileIO{rates: newRateTracker()}, nil
+}
+
+// Fetch returns one event per file type
+func (m *fileIO) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+	rows, err := QueryRows(ctx, db, "SELECT EVENT_NAME, COUNT(*) AS FILES, SUM(COUNT_READ) AS COUNT_READ, "+
+		"SUM(SUM_NUMBER_OF_BYTES_READ) AS SUM_NUMBER_OF_BYTES_READ, SUM(SUM_TIMER_READ) AS SUM_TIMER_READ, "+
+		"SUM(COUNT_WRITE) AS COUNT_WRITE, SUM(SUM_NUMBER_OF_BYTES_WRITE) AS SUM_NUMBER_OF_BYTES_WRITE, "+
+		"SUM(SUM_TIMER_WRITE) AS SUM_TIMER_WRITE "+
+		"FROM performance_schema.file_summary_by_instance GROUP BY EVENT_NAME")
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]map[string]uint64{}
+	var types []string
+	for _, row := range rows {
+		fileType, exists := fileTypes[row["EVENT_NAME"]]
+		if !exists {
+			fileType = "other"
+		}
+
+		counters, exists := totals[fileType]
+		if !exists {
+			counters = map[string]uint64{}
+			totals[fileType] = counters
+			types = append(types, fileType)
+		}
+
+		counters["FILES"] += parseUint(row["FILES"])
+		for column := range fileIOColumns {
+			counters[column] += parseUint(row[column])
+		}
+	}
+
+	var events []common.MapStr
+	for _, fileType := range types {
+		counters := totals[fileType]
+		fields := common.MapStr{
+			"type":  fileType,
+			"files": counters["FILES"],
+		}
+
+		for column, field := range fileIOColumns {
+			value := counters[column]
+
+			// Timer waits are in picoseconds
+			if column == "SUM_TIMER_READ" || column == "SUM_TIMER_WRITE" {
+				value /= 1000000
+			}
+
+			fields.Put(field, value)
+			if rate, ok := m.rates.rate(fileType+"/"+field, float64(value), now); ok {
+				fields.Put(field+rateSuffix, rate)
+			}
+		}
+
+		events = append(events, common.MapStr{"mysql": common.MapStr{"file_io": fields}})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,108 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("galera", newGalera)
+}
+
+// galeraStatusFields maps the numeric wsrep status variables to their fields
+var galeraStatusFields = map[string]statusField{
+	"wsrep_cluster_size":           {name: "cluster.size"},
+	"wsrep_cluster_conf_id":        {name: "cluster.conf_id"},
+	"wsrep_local_state":            {name: "local.state"},
+	"wsrep_local_index":            {name: "local.index"},
+	"wsrep_local_recv_queue":       {name: "local.recv_queue"},
+	"wsrep_local_send_queue":       {name: "local.send_queue"},
+	"wsrep_local_cert_failures":    {name: "local.cert_failures", counter: true},
+	"wsrep_local_bf_aborts":        {name: "local.bf_aborts", counter: true},
+	"wsrep_flow_control_paused":    {name: "flow_control.paused"},
+	"wsrep_flow_control_paused_ns": {name: "flow_control.paused_ns", counter: true},
+	"wsrep_flow_control_sent":      {name: "flow_control.sent", counter: true},
+	"wsrep_flow_control_recv":      {name: "flow_control.recv", counter: true},
+	"wsrep_replicated":             {name: "replicated.count", counter: true},
+	"wsrep_replicated_bytes":       {name: "replicated.bytes", counter: true},
+	"wsrep_received":               {name: "received.count", counter: true},
+	"wsrep_received_bytes":         {name: "received.bytes", counter: true},
+	"wsrep_cert_deps_distance":     {name: "cert.deps_distance"},
+	"wsrep_last_committed":         {name: "last_committed"},
+	"wsrep_apply_window":           {name: "apply_window"},
+	"wsrep_commit_window":          {name: "commit_window"},
+	"wsrep_evs_repl_latency":       {name: "evs_repl_latency"},
+	"wsrep_local_send_queue_avg":   {name: "local.send_queue_avg"},
+	"wsrep_local_recv_queue_avg":   {name: "local.recv_queue_avg"},
+}
+
+// galera collects the wsrep status of the Galera cluster nodes (Percona
+// XtraDB Cluster, MariaDB Galera Cluster) under mysql.galera, with an overall
+// cluster health. It emits no event on servers without wsrep.
+type galera struct {
+	rates *rateTracker
+}
+
+func newGalera(cfg *common.Config) (Module, error) {
+	return &galera{rates: newRateTracker()}, nil
+}
+
+// Fetch returns a single event with the node and cluster state
+func (m *galera) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+
+	// Servers without wsrep have no wsrep_on variable
+	settings, err := QueryVariables(ctx, db, "SHOW GLOBAL VARIABLES LIKE 'wsrep_on'")
+	if err != nil {
+		return nil, err
+	}
+	if settings["wsrep_on"] != "ON" {
+		return nil, nil
+	}
+
+	variables, err := QueryVariables(ctx, db, "SHOW GLOBAL STATUS LIKE 'wsrep%'")
+	if err != nil {
+		return nil, err
+	}
+
+	fields := common.MapStr{
+		"cluster.name":        variables["wsrep_cluster_name"],
+		"cluster.status":      variables["wsrep_cluster_status"],
+		"cluster.state_uuid":  variables["wsrep_cluster_state_uuid"],
+		"local.state_comment": variables["wsrep_local_state_comment"],
+		"ready":               variables["wsrep_ready"] == "ON",
+		"connected":           variables["wsrep_connected"] == "ON",
+	}
+
+	galeraFields := common.MapStr{}
+	for key, value := range fields {
+		galeraFields.Put(key, value)
+	}
+
+	for variable, field := range galeraStatusFields {
+		value, exists := variables[variable]
+		if !exists {
+			continue
+		}
+
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			continue
+		}
+
+		galeraFields.Put(field.name, ParseValue(value))
+		if field.counter {
+			m.rates.putRate(galeraFields, field.name, n, now)
+		}
+	}
+
+	// A healthy node is connected, ready and synced with the primary component
+	galeraFields.Put("health.healthy", fields["ready"] == true && fields["connected"] == true &&
+		fields["cluster.status"] == "Primary" && fields["local.state_comment"] == "Synced")
+
+	return []common.MapStr{{"mysql": common.MapStr{"galera": galeraFields}}}, nil
+}
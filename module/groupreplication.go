@@ -0,0 +1,92 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("group_replication", newGroupReplication)
+}
+
+// groupReplicationStats maps the replication_group_member_stats columns to their fields
+var groupReplicationStats = map[string]string{
+	"COUNT_TRANSACTIONS_IN_QUEUE":                "transactions.in_queue",
+	"COUNT_TRANSACTIONS_CHECKED":                 "transactions.checked",
+	"COUNT_CONFLICTS_DETECTED":                   "transactions.conflicts",
+	"COUNT_TRANSACTIONS_ROWS_VALIDATING":         "transactions.rows_validating",
+	"COUNT_TRANSACTIONS_REMOTE_IN_APPLIER_QUEUE": "transactions.remote_in_applier_queue",
+	"COUNT_TRANSACTIONS_REMOTE_APPLIED":          "transactions.remote_applied",
+	"COUNT_TRANSACTIONS_LOCAL_PROPOSED":          "transactions.local_proposed",
+	"COUNT_TRANSACTIONS_LOCAL_ROLLBACK":          "transactions.local_rollback",
+}
+
+// groupReplication reports the state of every MySQL Group Replication member
+// from performance_schema.replication_group_members and
+// replication_group_member_stats under mysql.group_replication. It emits no
+// event on servers that aren't group members.
+type groupReplication struct{}
+
+func newGroupReplication(cfg *common.Config) (Module, error) {
+	return &groupReplication{}, nil
+}
+
+// Fetch returns one event per group member
+func (m *groupReplication) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	members, err := QueryRows(ctx, db, "SELECT * FROM performance_schema.replication_group_members")
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := QueryRows(ctx, db, "SELECT * FROM performance_schema.replication_group_member_stats")
+	if err != nil {
+		return nil, err
+	}
+
+	statsByMember := map[string]Row{}
+	for _, row := range stats {
+		statsByMember[row["MEMBER_ID"]] = row
+	}
+
+	var events []common.MapStr
+	for _, member := range members {
+		// The group replication plugin lists the local member even when it's stopped
+		if member["MEMBER_ID"] == "" {
+			continue
+		}
+
+		fields := common.MapStr{
+			"group":        member["CHANNEL_NAME"],
+			"member.id":    member["MEMBER_ID"],
+			"member.host":  member["MEMBER_HOST"],
+			"member.state": member["MEMBER_STATE"],
+		}
+		putInt(fields, "member.port", member["MEMBER_PORT"])
+
+		// MySQL 8.0 adds the member role and version
+		if role, exists := member["MEMBER_ROLE"]; exists {
+			fields["member.role"] = role
+		}
+		if version, exists := member["MEMBER_VERSION"]; exists {
+			fields["member.version"] = version
+		}
+
+		if row, exists := statsByMember[member["MEMBER_ID"]]; exists {
+			fields["view_id"] = row["VIEW_ID"]
+			for column, field := range groupReplicationStats {
+				putInt(fields, field, row[column])
+			}
+		}
+
+		groupFields := common.MapStr{}
+		for key, value := range fields {
+			groupFields.Put(key, value)
+		}
+
+		events = append(events, common.MapStr{"mysql": common.MapStr{"group_replication": groupFields}})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,80 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("innodb", newInnoDB)
+}
+
+type innoDBConfig struct {
+	Include []string `config:"include"`
+}
+
+// innoDB collects the enabled counters of information_schema.INNODB_METRICS
+// under mysql.innodb. Gauges (value type) are reported as is, counters also
+// have their per second rate.
+type innoDB struct {
+	config innoDBConfig
+	rates  *rateTracker
+}
+
+func newInnoDB(cfg *common.Config) (Module, error) {
+	c := innoDBConfig{
+		Include: []string{"*"},
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	if err := checkPatterns(c.Include); err != nil {
+		return nil, err
+	}
+
+	return &innoDB{config: c, rates: newRateTracker()}, nil
+}
+
+// Fetch returns a single event with all the enabled counters
+func (m *innoDB) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+	rows, err := QueryRows(ctx, db, "SELECT * FROM information_schema.INNODB_METRICS")
+	if err != nil {
+		return nil, err
+	}
+
+	fields := common.MapStr{}
+	var disabled []string
+	for _, row := range rows {
+		name := strings.ToLower(row["NAME"])
+		if !matchAny(m.config.Include, name) {
+			continue
+		}
+
+		// MySQL 8.0.15 replaced the STATUS column with the ENABLED column
+		if row["STATUS"] == "disabled" || row["ENABLED"] == "0" {
+			disabled = append(disabled, name)
+			continue
+		}
+
+		count, err := strconv.ParseInt(row["COUNT"], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		fields[name] = count
+		if row["TYPE"] != "value" {
+			m.rates.putRate(fields, name, float64(count), now)
+		}
+	}
+
+	fields["disabled_count"] = len(disabled)
+
+	return []common.MapStr{{"mysql": common.MapStr{"innodb": fields}}}, nil
+}
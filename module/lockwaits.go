@@ -0,0 +1,105 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("lock_waits", newLockWaits)
+}
+
+const (
+	// lockWaitsSys reads sys.innodb_lock_waits (MySQL 5.7+ with the sys schema)
+	lockWaitsSys = "SELECT wait_age_secs, locked_table, locked_index, locked_type, " +
+		"waiting_trx_id, waiting_pid, waiting_query, waiting_lock_mode, " +
+		"blocking_trx_id, blocking_pid, blocking_query, blocking_lock_mode " +
+		"FROM sys.innodb_lock_waits"
+
+	// lockWaitsInformationSchema reads the same columns from the
+	// information_schema tables of MySQL 5.6 and 5.7 without the sys schema
+	lockWaitsInformationSchema = "SELECT TIMESTAMPDIFF(SECOND, r.trx_wait_started, NOW()) AS wait_age_secs, " +
+		"rl.lock_table AS locked_table, rl.lock_index AS locked_index, rl.lock_type AS locked_type, " +
+		"r.trx_id AS waiting_trx_id, r.trx_mysql_thread_id AS waiting_pid, r.trx_query AS waiting_query, " +
+		"rl.lock_mode AS waiting_lock_mode, b.trx_id AS blocking_trx_id, b.trx_mysql_thread_id AS blocking_pid, " +
+		"b.trx_query AS blocking_query, bl.lock_mode AS blocking_lock_mode " +
+		"FROM information_schema.INNODB_LOCK_WAITS w " +
+		"JOIN information_schema.INNODB_TRX b ON b.trx_id = w.blocking_trx_id " +
+		"JOIN information_schema.INNODB_TRX r ON r.trx_id = w.requesting_trx_id " +
+		"JOIN information_schema.INNODB_LOCKS bl ON bl.lock_id = w.blocking_lock_id " +
+		"JOIN information_schema.INNODB_LOCKS rl ON rl.lock_id = w.requested_lock_id"
+)
+
+// lockWaits reports the current InnoDB lock waits under mysql.lock_wait, with
+// the waiting and blocking transactions, the locked table and the root of the
+// blocking chain (the blocking thread that doesn't wait itself)
+type lockWaits struct {
+	query string
+}
+
+func newLockWaits(cfg *common.Config) (Module, error) {
+	return &lockWaits{query: lockWaitsSys}, nil
+}
+
+// Fetch returns one event per lock wait
+func (m *lockWaits) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, m.query)
+	if err != nil && m.query == lockWaitsSys {
+		// The sys schema isn't installed, use the information_schema tables from now on
+		rows, err = QueryRows(ctx, db, lockWaitsInformationSchema)
+		if err == nil {
+			m.query = lockWaitsInformationSchema
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// blockedBy maps the waiting threads to their blocking thread
+	blockedBy := map[string]string{}
+	for _, row := range rows {
+		blockedBy[row["waiting_pid"]] = row["blocking_pid"]
+	}
+
+	var events []common.MapStr
+	for _, row := range rows {
+		root, depth := row["blocking_pid"], 1
+		for depth <= len(blockedBy) {
+			next, waits := blockedBy[root]
+			if !waits {
+				break
+			}
+			root = next
+			depth++
+		}
+
+		fields := common.MapStr{
+			"table": row["locked_table"],
+			"index": row["locked_index"],
+			"type":  row["locked_type"],
+			"waiting": common.MapStr{
+				"trx_id":    row["waiting_trx_id"],
+				"pid":       parseInt(row["waiting_pid"]),
+				"query":     row["waiting_query"],
+				"lock_mode": row["waiting_lock_mode"],
+			},
+			"blocking": common.MapStr{
+				"trx_id":    row["blocking_trx_id"],
+				"pid":       parseInt(row["blocking_pid"]),
+				"query":     row["blocking_query"],
+				"lock_mode": row["blocking_lock_mode"],
+			},
+			"chain": common.MapStr{
+				"root_pid": parseInt(root),
+				"depth":    depth,
+			},
+		}
+		putInt(fields, "wait_seconds", row["wait_age_secs"])
+
+		events = append(events, common.MapStr{"mysql": common.MapStr{"lock_wait": fields}})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,111 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("memory", newMemory)
+}
+
+type memoryConfig struct {
+	Top int `config:"top" validate:"min=1"`
+}
+
+// memory reports the memory allocated by the server from the
+// performance_schema memory instrumentation: the total, and the current and
+// high watermark allocations of the top instruments and accounts, under
+// mysql.memory. The memory/% instruments must be enabled in setup_instruments.
+type memory struct {
+	config memoryConfig
+}
+
+func newMemory(cfg *common.Config) (Module, error) {
+	c := memoryConfig{
+		Top: 10,
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	return &memory{config: c}, nil
+}
+
+// Fetch returns a total event, then one event per top instrument and per top account
+func (m *memory) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	totals, err := QueryRows(ctx, db, "SELECT COUNT(*) AS INSTRUMENTS, SUM(CURRENT_NUMBER_OF_BYTES_USED) AS CURRENT_BYTES "+
+		"FROM performance_schema.memory_summary_global_by_event_name WHERE CURRENT_NUMBER_OF_BYTES_USED > 0")
+	if err != nil {
+		return nil, err
+	}
+
+	instruments, err := QueryRows(ctx, db, fmt.Sprintf("SELECT EVENT_NAME, CURRENT_COUNT_USED, CURRENT_NUMBER_OF_BYTES_USED, "+
+		"HIGH_COUNT_USED, HIGH_NUMBER_OF_BYTES_USED FROM performance_schema.memory_summary_global_by_event_name "+
+		"ORDER BY CURRENT_NUMBER_OF_BYTES_USED DESC LIMIT %d", m.config.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	// The background threads have no user
+	accounts, err := QueryRows(ctx, db, fmt.Sprintf("SELECT USER, HOST, SUM(CURRENT_COUNT_USED) AS CURRENT_COUNT_USED, "+
+		"SUM(CURRENT_NUMBER_OF_BYTES_USED) AS CURRENT_NUMBER_OF_BYTES_USED, SUM(HIGH_NUMBER_OF_BYTES_USED) AS HIGH_NUMBER_OF_BYTES_USED "+
+		"FROM performance_schema.memory_summary_by_account_by_event_name WHERE USER IS NOT NULL "+
+		"GROUP BY USER, HOST ORDER BY CURRENT_NUMBER_OF_BYTES_USED DESC LIMIT %d", m.config.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	var events []common.MapStr
+	if len(totals) > 0 {
+		events = append(events, memoryEvent(common.MapStr{
+			"total": common.MapStr{
+				"instruments":   parseInt(totals[0]["INSTRUMENTS"]),
+				"current.bytes": parseInt(totals[0]["CURRENT_BYTES"]),
+			},
+		}))
+	}
+
+	for i, row := range instruments {
+		events = append(events, memoryEvent(common.MapStr{
+			"instrument": common.MapStr{
+				"rank":          i + 1,
+				"name":          row["EVENT_NAME"],
+				"current.count": parseInt(row["CURRENT_COUNT_USED"]),
+				"current.bytes": parseInt(row["CURRENT_NUMBER_OF_BYTES_USED"]),
+				"high.count":    parseInt(row["HIGH_COUNT_USED"]),
+				"high.bytes":    parseInt(row["HIGH_NUMBER_OF_BYTES_USED"]),
+			},
+		}))
+	}
+
+	for i, row := range accounts {
+		events = append(events, memoryEvent(common.MapStr{
+			"account": common.MapStr{
+				"rank":          i + 1,
+				"user":          row["USER"],
+				"host":          row["HOST"],
+				"current.count": parseInt(row["CURRENT_COUNT_USED"]),
+				"current.bytes": parseInt(row["CURRENT_NUMBER_OF_BYTES_USED"]),
+				"high.bytes":    parseInt(row["HIGH_NUMBER_OF_BYTES_USED"]),
+			},
+		}))
+	}
+
+	return events, nil
+}
+
+// memoryEvent nests the dotted fields of a memory event under mysql.memory
+func memoryEvent(fields common.MapStr) common.MapStr {
+	memoryFields := common.MapStr{}
+	for kind, values := range fields {
+		for key, value := range values.(common.MapStr) {
+			memoryFields.Put(kind+"."+key, value)
+		}
+	}
+
+	return common.MapStr{"mysql": common.MapStr{"memory": memoryFields}}
+}
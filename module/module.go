@@ -0,0 +1,210 @@
+// Package module contains the built-in modules, which collect a predefined
+// set of metrics from a MySQL server without any user SQL.
+package module
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// Module collects a predefined set of metrics from a MySQL server. A module
+// instance is created for every monitored host, so it can keep state between
+// fetches.
+type Module interface {
+	// Fetch runs the module queries and returns the fields of the events to
+	// publish, the queries are canceled with ctx
+	Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error)
+}
+
+// Factory creates a module from its configuration
+type Factory func(cfg *common.Config) (Module, error)
+
+// Config is the configuration shared by all the modules, a module without a
+// period runs on every collection cycle. The events are processed by the
+// pipeline ingest pipeline when it's set.
+type Config struct {
+	Module   string        `config:"module" validate:"required"`
+	Group    string        `config:"group"`
+	Period   time.Duration `config:"period"`
+	Pipeline string        `config:"pipeline"`
+}
+
+var registry = map[string]Factory{}
+
+// Register registers a module factory by name, it panics when the name is
+// already registered.
+func Register(name string, factory Factory) {
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("module %v is already registered", name))
+	}
+
+	registry[name] = factory
+}
+
+// New creates a module from its configuration
+func New(cfg *common.Config) (Module, Config, error) {
+	var c Config
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, c, err
+	}
+
+	factory, exists := registry[c.Module]
+	if !exists {
+		return nil, c, fmt.Errorf("unknown module: %v", c.Module)
+	}
+
+	m, err := factory(cfg)
+	if err != nil {
+		return nil, c, fmt.Errorf("module %v: %v", c.Module, err)
+	}
+
+	return m, c, nil
+}
+
+// Names returns the names of the registered modules
+func Names() []string {
+	var names []string
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Row is a result row, by column name. NULL columns are omitted.
+type Row map[string]string
+
+// QueryRows runs a query and returns all the result rows
+func QueryRows(ctx context.Context, db *sql.DB, query string) ([]Row, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	// Make a slice for the values
+	values := make([]sql.RawBytes, len(columns))
+
+	// Copy the references into such a []interface{} for row.Scan
+	scanArgs := make([]interface{}, len(values))
+	for i := range values {
+		scanArgs[i] = &values[i]
+	}
+
+	var result []Row
+	for rows.Next() {
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, err
+		}
+
+		row := Row{}
+		for i, value := range values {
+			if value != nil {
+				row[columns[i]] = string(value)
+			}
+		}
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
+
+// QueryVariables runs a two-columns query (such as SHOW GLOBAL STATUS) and
+// returns the values by name
+func QueryVariables(ctx context.Context, db *sql.DB, query string) (map[string]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	variables := map[string]string{}
+	for rows.Next() {
+		var name string
+		var value sql.NullString
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, err
+		}
+
+		if value.Valid {
+			variables[name] = value.String
+		}
+	}
+
+	return variables, rows.Err()
+}
+
+// ParseValue converts a value to an int64 or a float64 when it's a decimal
+// number, otherwise it's kept as a string
+func ParseValue(value string) interface{} {
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n
+	}
+
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+
+	return value
+}
+
+// RowFields converts a row to event fields, the columns listed in stringColumns are
+// always kept as strings
+func RowFields(row Row, stringColumns ...string) common.MapStr {
+	keep := map[string]bool{}
+	for _, column := range stringColumns {
+		keep[column] = true
+	}
+
+	fields := common.MapStr{}
+	for column, value := range row {
+		if keep[column] {
+			fields[column] = value
+		} else {
+			fields[column] = ParseValue(value)
+		}
+	}
+
+	return fields
+}
+
+// checkPatterns checks the syntax of name patterns
+func checkPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %v: %v", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// matchAny returns whether the name matches one of the patterns
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseInt parses an integer value, invalid values are 0
+func parseInt(value string) int64 {
+	n, _ := strconv.ParseInt(value, 10, 64)
+	return n
+}
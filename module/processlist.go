@@ -0,0 +1,110 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("processlist", newProcesslist)
+}
+
+type processlistConfig struct {
+	LongQueryThreshold time.Duration `config:"long_query_threshold"`
+}
+
+// processlist summarizes SHOW FULL PROCESSLIST under mysql.processlist: the
+// threads by state, command and user, the longest running query and the
+// number of queries running for longer than the threshold
+type processlist struct {
+	config processlistConfig
+}
+
+func newProcesslist(cfg *common.Config) (Module, error) {
+	c := processlistConfig{
+		LongQueryThreshold: 10 * time.Second,
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	return &processlist{config: c}, nil
+}
+
+// Fetch returns a single summary event
+func (m *processlist) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, "SHOW FULL PROCESSLIST")
+	if err != nil {
+		return nil, err
+	}
+
+	byState := common.MapStr{}
+	byCommand := common.MapStr{}
+	byUser := common.MapStr{}
+	longQueries := 0
+	var longest Row
+	var longestTime int64 = -1
+
+	for _, row := range rows {
+		increment(byState, summaryKey(row["State"]))
+		increment(byCommand, summaryKey(row["Command"]))
+		increment(byUser, summaryKey(row["User"]))
+
+		// Only the threads running a statement are queries
+		if row["Command"] != "Query" || row["Info"] == "" {
+			continue
+		}
+
+		seconds := parseInt(row["Time"])
+		if time.Duration(seconds)*time.Second >= m.config.LongQueryThreshold {
+			longQueries++
+		}
+		if seconds > longestTime {
+			longest, longestTime = row, seconds
+		}
+	}
+
+	fields := common.MapStr{
+		"threads":      len(rows),
+		"by_state":     byState,
+		"by_command":   byCommand,
+		"by_user":      byUser,
+		"long_queries": longQueries,
+	}
+
+	if longest != nil {
+		fields["longest_query"] = common.MapStr{
+			"seconds": longestTime,
+			"id":      parseInt(longest["Id"]),
+			"user":    longest["User"],
+			"db":      longest["db"],
+			"state":   longest["State"],
+			"info":    longest["Info"],
+		}
+	}
+
+	return []common.MapStr{{"mysql": common.MapStr{"processlist": fields}}}, nil
+}
+
+// increment increments a counter of a summary
+func increment(summary common.MapStr, key string) {
+	n, _ := summary[key].(int)
+	summary[key] = n + 1
+}
+
+// summaryKey converts a value to a summary field name
+func summaryKey(value string) string {
+	if value == "" {
+		return "none"
+	}
+
+	key := strings.ToLower(value)
+	key = strings.Replace(key, " ", "_", -1)
+	key = strings.Replace(key, ".", "_", -1)
+
+	return key
+}
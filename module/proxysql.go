@@ -0,0 +1,108 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("proxysql", newProxySQL)
+}
+
+// proxySQLTable is a ProxySQL admin table collected by the proxysql module
+type proxySQLTable struct {
+	sql string
+
+	// Columns that look numeric but must be kept as strings (e.g. hex digests)
+	stringColumns []string
+}
+
+var proxySQLTables = map[string]proxySQLTable{
+	"connection_pool": {
+		sql:           "SELECT * FROM stats.stats_mysql_connection_pool",
+		stringColumns: []string{"srv_host", "status"},
+	},
+	"query_digest": {
+		sql:           "SELECT * FROM stats.stats_mysql_query_digest ORDER BY sum_time DESC LIMIT %d",
+		stringColumns: []string{"schemaname", "username", "client_address", "digest", "digest_text"},
+	},
+	"hostgroups": {
+		sql:           "SELECT * FROM runtime_mysql_servers",
+		stringColumns: []string{"hostname", "status", "comment"},
+	},
+	"global": {
+		sql: "SELECT Variable_Name, Variable_Value FROM stats.stats_mysql_global",
+	},
+}
+
+type proxySQLConfig struct {
+	Tables      []string `config:"tables"`
+	DigestLimit int      `config:"digest_limit" validate:"min=1"`
+}
+
+// proxySQL collects the stats tables of the ProxySQL admin interface, whose
+// values are all reported as strings
+type proxySQL struct {
+	config proxySQLConfig
+}
+
+func newProxySQL(cfg *common.Config) (Module, error) {
+	c := proxySQLConfig{
+		Tables:      []string{"connection_pool", "query_digest", "hostgroups", "global"},
+		DigestLimit: 100,
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	for _, table := range c.Tables {
+		if _, exists := proxySQLTables[table]; !exists {
+			return nil, fmt.Errorf("unknown proxysql table: %v", table)
+		}
+	}
+
+	return &proxySQL{config: c}, nil
+}
+
+// Fetch returns one event per row of the row based tables and a single event
+// for the global stats
+func (m *proxySQL) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	var events []common.MapStr
+
+	for _, name := range m.config.Tables {
+		table := proxySQLTables[name]
+
+		if name == "global" {
+			variables, err := QueryVariables(ctx, db, table.sql)
+			if err != nil {
+				return nil, err
+			}
+
+			fields := common.MapStr{}
+			for variable, value := range variables {
+				fields[variable] = ParseValue(value)
+			}
+			events = append(events, common.MapStr{"proxysql": common.MapStr{name: fields}})
+			continue
+		}
+
+		query := table.sql
+		if name == "query_digest" {
+			query = fmt.Sprintf(query, m.config.DigestLimit)
+		}
+
+		rows, err := QueryRows(ctx, db, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			events = append(events, common.MapStr{"proxysql": common.MapStr{name: RowFields(row, table.stringColumns...)}})
+		}
+	}
+
+	return events, nil
+}
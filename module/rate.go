@@ -0,0 +1,68 @@
+package module
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+// rateSuffix is appended to the name of the fields holding the per second
+// rate of a counter
+const rateSuffix = "_per_sec"
+
+// rateTracker computes the per second rates of counters between fetches, a
+// counter that decreased (e.g. after a server restart) has a rate of 0
+type rateTracker struct {
+	values map[string]float64
+	times  map[string]time.Time
+}
+
+func newRateTracker() *rateTracker {
+	return &rateTracker{
+		values: map[string]float64{},
+		times:  map[string]time.Time{},
+	}
+}
+
+// rate saves the counter value and returns its rate since the previous value,
+// ok is false when there is no previous value
+func (r *rateTracker) rate(key string, value float64, now time.Time) (rate float64, ok bool) {
+	oldValue, exists := r.values[key]
+	oldTime := r.times[key]
+
+	r.values[key] = value
+	r.times[key] = now
+
+	if !exists {
+		return 0, false
+	}
+
+	seconds := now.Sub(oldTime).Seconds()
+	if seconds <= 0 {
+		return 0, false
+	}
+
+	if value > oldValue {
+		return (value - oldValue) / seconds, true
+	}
+
+	return 0, true
+}
+
+// retain forgets the counters missing from keys, such as the counters of the
+// accounts that are gone, so that their values don't pile up
+func (r *rateTracker) retain(keys map[string]bool) {
+	for key := range r.values {
+		if !keys[key] {
+			delete(r.values, key)
+			delete(r.times, key)
+		}
+	}
+}
+
+// putRate adds the rate of a counter next to its value in fields
+func (r *rateTracker) putRate(fields common.MapStr, key string, value float64, now time.Time) {
+	if rate, ok := r.rate(key, value, now); ok {
+		fields.Put(key+rateSuffix, rate)
+	}
+}
@@ -0,0 +1,42 @@
+// +build !integration
+
+package module
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateTracker(t *testing.T) {
+	r := newRateTracker()
+	now := time.Now()
+
+	if _, ok := r.rate("queries", 100, now); ok {
+		t.Fatal("expected no rate without a previous value")
+	}
+
+	if rate, ok := r.rate("queries", 150, now.Add(10*time.Second)); !ok || rate != 5 {
+		t.Fatalf("expected a rate of 5, got %v (%v)", rate, ok)
+	}
+
+	// The counter was reset by a server restart
+	if rate, ok := r.rate("queries", 10, now.Add(20*time.Second)); !ok || rate != 0 {
+		t.Fatalf("expected a rate of 0, got %v (%v)", rate, ok)
+	}
+}
+
+func TestRateTrackerRetain(t *testing.T) {
+	r := newRateTracker()
+	now := time.Now()
+	r.rate("app@10.0.0.1/rows.read", 100, now)
+	r.rate("app@10.0.0.2/rows.read", 100, now)
+
+	r.retain(map[string]bool{"app@10.0.0.2/rows.read": true})
+
+	if _, exists := r.values["app@10.0.0.1/rows.read"]; exists || len(r.times) != 1 {
+		t.Fatalf("expected the counter of the gone account to be forgotten, got %v", r.values)
+	}
+	if _, ok := r.rate("app@10.0.0.2/rows.read", 150, now.Add(10*time.Second)); !ok {
+		t.Fatal("expected the retained counter to keep its previous value")
+	}
+}
@@ -0,0 +1,89 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("replication", newReplication)
+}
+
+// replication collects the replication state of every channel (multi-source
+// replication) under mysql.replication, from SHOW SLAVE STATUS. It emits no
+// event on servers that aren't replicas.
+type replication struct{}
+
+func newReplication(cfg *common.Config) (Module, error) {
+	return &replication{}, nil
+}
+
+// Fetch returns one event per replication channel
+func (m *replication) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, "SHOW SLAVE STATUS")
+	if err != nil {
+		return nil, err
+	}
+
+	var events []common.MapStr
+	for _, row := range rows {
+		fields := common.MapStr{
+			"channel":          row["Channel_Name"],
+			"source.host":      row["Master_Host"],
+			"source.uuid":      row["Master_UUID"],
+			"io_thread.state":  row["Slave_IO_State"],
+			"io_thread.status": row["Slave_IO_Running"],
+			"sql_thread.state": row["Slave_SQL_Running_State"],
+		}
+
+		fields["io_thread.running"] = row["Slave_IO_Running"] == "Yes"
+		fields["sql_thread.running"] = row["Slave_SQL_Running"] == "Yes"
+
+		// Seconds_Behind_Master is NULL when the SQL thread isn't running
+		if lag, exists := row["Seconds_Behind_Master"]; exists {
+			putInt(fields, "lag.seconds", lag)
+		}
+
+		putInt(fields, "source.port", row["Master_Port"])
+		putInt(fields, "source.server_id", row["Master_Server_Id"])
+		fields["source.log_file"] = row["Master_Log_File"]
+		putInt(fields, "source.log_position", row["Read_Master_Log_Pos"])
+		fields["exec.log_file"] = row["Relay_Master_Log_File"]
+		putInt(fields, "exec.log_position", row["Exec_Master_Log_Pos"])
+		putInt(fields, "relay_log.space", row["Relay_Log_Space"])
+
+		fields["gtid.auto_position"] = row["Auto_Position"] == "1"
+		fields["gtid.retrieved"] = cleanGTIDSet(row["Retrieved_Gtid_Set"])
+		fields["gtid.executed"] = cleanGTIDSet(row["Executed_Gtid_Set"])
+
+		putInt(fields, "io_error.code", row["Last_IO_Errno"])
+		fields["io_error.message"] = row["Last_IO_Error"]
+		putInt(fields, "sql_error.code", row["Last_SQL_Errno"])
+		fields["sql_error.message"] = row["Last_SQL_Error"]
+
+		replicationFields := common.MapStr{}
+		for key, value := range fields {
+			replicationFields.Put(key, value)
+		}
+
+		events = append(events, common.MapStr{"mysql": common.MapStr{"replication": replicationFields}})
+	}
+
+	return events, nil
+}
+
+// putInt adds an integer value to fields, invalid values are skipped
+func putInt(fields common.MapStr, key string, value string) {
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		fields[key] = n
+	}
+}
+
+// cleanGTIDSet removes the line breaks the server adds to long GTID sets
+func cleanGTIDSet(set string) string {
+	return strings.Replace(strings.Replace(set, "\n", "", -1), "\r", "", -1)
+}
@@ -0,0 +1,104 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("status", newStatus)
+}
+
+// statusField is a curated global status variable
+type statusField struct {
+	name    string
+	counter bool
+}
+
+// statusFields maps the curated global status variables to their fields
+var statusFields = map[string]statusField{
+	"Uptime":                            {name: "uptime"},
+	"Connections":                       {name: "connections", counter: true},
+	"Max_used_connections":              {name: "max_used_connections"},
+	"Aborted_clients":                   {name: "aborted.clients", counter: true},
+	"Aborted_connects":                  {name: "aborted.connects", counter: true},
+	"Threads_cached":                    {name: "threads.cached"},
+	"Threads_connected":                 {name: "threads.connected"},
+	"Threads_running":                   {name: "threads.running"},
+	"Threads_created":                   {name: "threads.created", counter: true},
+	"Bytes_received":                    {name: "bytes.received", counter: true},
+	"Bytes_sent":                        {name: "bytes.sent", counter: true},
+	"Queries":                           {name: "queries", counter: true},
+	"Questions":                         {name: "questions", counter: true},
+	"Slow_queries":                      {name: "slow_queries", counter: true},
+	"Com_select":                        {name: "command.select", counter: true},
+	"Com_insert":                        {name: "command.insert", counter: true},
+	"Com_update":                        {name: "command.update", counter: true},
+	"Com_delete":                        {name: "command.delete", counter: true},
+	"Open_tables":                       {name: "open_tables"},
+	"Opened_tables":                     {name: "opened_tables", counter: true},
+	"Open_files":                        {name: "open_files"},
+	"Table_locks_waited":                {name: "table_locks.waited", counter: true},
+	"Table_locks_immediate":             {name: "table_locks.immediate", counter: true},
+	"Created_tmp_tables":                {name: "created.tmp.tables", counter: true},
+	"Created_tmp_disk_tables":           {name: "created.tmp.disk_tables", counter: true},
+	"Created_tmp_files":                 {name: "created.tmp.files", counter: true},
+	"Select_full_join":                  {name: "select.full_join", counter: true},
+	"Select_scan":                       {name: "select.scan", counter: true},
+	"Sort_merge_passes":                 {name: "sort.merge_passes", counter: true},
+	"Innodb_buffer_pool_pages_total":    {name: "innodb.buffer_pool.pages.total"},
+	"Innodb_buffer_pool_pages_free":     {name: "innodb.buffer_pool.pages.free"},
+	"Innodb_buffer_pool_pages_dirty":    {name: "innodb.buffer_pool.pages.dirty"},
+	"Innodb_buffer_pool_read_requests":  {name: "innodb.buffer_pool.read_requests", counter: true},
+	"Innodb_buffer_pool_reads":          {name: "innodb.buffer_pool.reads", counter: true},
+	"Innodb_buffer_pool_write_requests": {name: "innodb.buffer_pool.write_requests", counter: true},
+	"Innodb_row_lock_waits":             {name: "innodb.row_lock.waits", counter: true},
+	"Innodb_row_lock_time":              {name: "innodb.row_lock.time", counter: true},
+	"Innodb_rows_read":                  {name: "innodb.rows.read", counter: true},
+	"Innodb_rows_inserted":              {name: "innodb.rows.inserted", counter: true},
+	"Innodb_rows_updated":               {name: "innodb.rows.updated", counter: true},
+	"Innodb_rows_deleted":               {name: "innodb.rows.deleted", counter: true},
+}
+
+// status collects the curated global status variables under mysql.status,
+// with the per second rates of the counters
+type status struct {
+	rates *rateTracker
+}
+
+func newStatus(cfg *common.Config) (Module, error) {
+	return &status{rates: newRateTracker()}, nil
+}
+
+// Fetch returns a single event with all the curated variables
+func (m *status) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+	variables, err := QueryVariables(ctx, db, "SHOW GLOBAL STATUS")
+	if err != nil {
+		return nil, err
+	}
+
+	fields := common.MapStr{}
+	for variable, field := range statusFields {
+		value, exists := variables[variable]
+		if !exists {
+			continue
+		}
+
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		fields.Put(field.name, n)
+		if field.counter {
+			m.rates.putRate(fields, field.name, float64(n), now)
+		}
+	}
+
+	return []common.MapStr{{"mysql": common.MapStr{"status": fields}}}, nil
+}
@@ -0,0 +1,124 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("table_io", newTableIO)
+}
+
+// tableIOColumns maps the table_io_waits_summary_by_table columns to their fields
+var tableIOColumns = map[string]string{
+	"COUNT_READ":      "read.count",
+	"SUM_TIMER_READ":  "read.latency_us",
+	"COUNT_WRITE":     "write.count",
+	"SUM_TIMER_WRITE": "write.latency_us",
+	"COUNT_FETCH":     "fetch.count",
+	"COUNT_INSERT":    "insert.count",
+	"COUNT_UPDATE":    "update.count",
+	"COUNT_DELETE":    "delete.count",
+}
+
+type tableIOConfig struct {
+	ExcludeSchemas []string `config:"exclude_schemas"`
+}
+
+// tableIO reports the read/write counts and latencies of the tables with I/O
+// activity during the last interval, from
+// performance_schema.table_io_waits_summary_by_table, under mysql.table_io
+type tableIO struct {
+	config   tableIOConfig
+	previous map[string]map[string]uint64
+}
+
+func newTableIO(cfg *common.Config) (Module, error) {
+	c := tableIOConfig{
+		ExcludeSchemas: []string{"mysql", "performance_schema", "information_schema", "sys"},
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	if err := checkPatterns(c.ExcludeSchemas); err != nil {
+		return nil, err
+	}
+
+	return &tableIO{config: c}, nil
+}
+
+// Fetch returns one event per active table, there are no events on the first
+// fetch since the interval deltas can't be computed yet
+func (m *tableIO) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, "SELECT * FROM performance_schema.table_io_waits_summary_by_table")
+	if err != nil {
+		return nil, err
+	}
+
+	current := map[string]map[string]uint64{}
+	var events []common.MapStr
+	for _, row := range rows {
+		schema, table := row["OBJECT_SCHEMA"], row["OBJECT_NAME"]
+		if matchAny(m.config.ExcludeSchemas, schema) {
+			continue
+		}
+
+		key := schema + "." + table
+		counters := map[string]uint64{}
+		for column := range tableIOColumns {
+			counters[column] = parseUint(row[column])
+		}
+		current[key] = counters
+
+		if m.previous == nil {
+			continue
+		}
+
+		// A table that is new or was reset counts from zero
+		previous, exists := m.previous[key]
+		for column := range tableIOColumns {
+			if counters[column] < previous[column] {
+				exists = false
+			}
+		}
+		if !exists {
+			previous = map[string]uint64{}
+		}
+
+		if counters["COUNT_READ"] == previous["COUNT_READ"] && counters["COUNT_WRITE"] == previous["COUNT_WRITE"] {
+			continue
+		}
+
+		interval := common.MapStr{}
+		total := common.MapStr{}
+		for column, field := range tableIOColumns {
+			value, delta := counters[column], counters[column]-previous[column]
+
+			// Timer waits are in picoseconds
+			if column == "SUM_TIMER_READ" || column == "SUM_TIMER_WRITE" {
+				value, delta = value/1000000, delta/1000000
+			}
+
+			total.Put(field, value)
+			interval.Put(field, delta)
+		}
+
+		events = append(events, common.MapStr{
+			"mysql": common.MapStr{
+				"table_io": common.MapStr{
+					"schema":   schema,
+					"table":    table,
+					"interval": interval,
+					"total":    total,
+				},
+			},
+		})
+	}
+
+	m.previous = current
+
+	return events, nil
+}
@@ -0,0 +1,110 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("table_size", newTableSize)
+}
+
+type tableSizeConfig struct {
+	IncludeSchemas []string `config:"include_schemas"`
+	ExcludeSchemas []string `config:"exclude_schemas"`
+	Tables         bool     `config:"tables"`
+}
+
+// tableSize reports the data and index sizes and the row estimates of every
+// schema (under mysql.schema_size) and table (under mysql.table_size) from
+// information_schema.TABLES. It's meant to run with a long period.
+type tableSize struct {
+	config tableSizeConfig
+}
+
+func newTableSize(cfg *common.Config) (Module, error) {
+	c := tableSizeConfig{
+		IncludeSchemas: []string{"*"},
+		ExcludeSchemas: []string{"mysql", "performance_schema", "information_schema", "sys"},
+		Tables:         true,
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	if err := checkPatterns(append(append([]string{}, c.IncludeSchemas...), c.ExcludeSchemas...)); err != nil {
+		return nil, err
+	}
+
+	return &tableSize{config: c}, nil
+}
+
+// Fetch returns one event per schema and, when enabled, one event per table
+func (m *tableSize) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	rows, err := QueryRows(ctx, db, "SELECT TABLE_SCHEMA, TABLE_NAME, ENGINE, TABLE_ROWS, DATA_LENGTH, INDEX_LENGTH, DATA_FREE "+
+		"FROM information_schema.TABLES WHERE TABLE_TYPE = 'BASE TABLE'")
+	if err != nil {
+		return nil, err
+	}
+
+	var events []common.MapStr
+	schemas := map[string]common.MapStr{}
+	var schemaNames []string
+
+	for _, row := range rows {
+		schema := row["TABLE_SCHEMA"]
+		if !matchAny(m.config.IncludeSchemas, schema) || matchAny(m.config.ExcludeSchemas, schema) {
+			continue
+		}
+
+		data, index := parseUint(row["DATA_LENGTH"]), parseUint(row["INDEX_LENGTH"])
+		tableRows, free := parseUint(row["TABLE_ROWS"]), parseUint(row["DATA_FREE"])
+
+		summary, exists := schemas[schema]
+		if !exists {
+			summary = common.MapStr{
+				"schema":      schema,
+				"tables":      0,
+				"rows":        uint64(0),
+				"data_bytes":  uint64(0),
+				"index_bytes": uint64(0),
+				"free_bytes":  uint64(0),
+				"total_bytes": uint64(0),
+			}
+			schemas[schema] = summary
+			schemaNames = append(schemaNames, schema)
+		}
+
+		summary["tables"] = summary["tables"].(int) + 1
+		summary["rows"] = summary["rows"].(uint64) + tableRows
+		summary["data_bytes"] = summary["data_bytes"].(uint64) + data
+		summary["index_bytes"] = summary["index_bytes"].(uint64) + index
+		summary["free_bytes"] = summary["free_bytes"].(uint64) + free
+		summary["total_bytes"] = summary["total_bytes"].(uint64) + data + index
+
+		if m.config.Tables {
+			events = append(events, common.MapStr{
+				"mysql": common.MapStr{
+					"table_size": common.MapStr{
+						"schema":      schema,
+						"table":       row["TABLE_NAME"],
+						"engine":      row["ENGINE"],
+						"rows":        tableRows,
+						"data_bytes":  data,
+						"index_bytes": index,
+						"free_bytes":  free,
+						"total_bytes": data + index,
+					},
+				},
+			})
+		}
+	}
+
+	for _, schema := range schemaNames {
+		events = append(events, common.MapStr{"mysql": common.MapStr{"schema_size": schemas[schema]}})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,112 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("tmp_tables", newTmpTables)
+}
+
+// tmpTablesFields maps the temporary tables status variables to their fields
+var tmpTablesFields = map[string]statusField{
+	"Created_tmp_tables":      {name: "created.tables", counter: true},
+	"Created_tmp_disk_tables": {name: "created.disk_tables", counter: true},
+	"Created_tmp_files":       {name: "created.files", counter: true},
+}
+
+type tmpTablesConfig struct {
+	Tmpdir bool `config:"tmpdir"`
+}
+
+// tmpTables reports the creation rates of the internal temporary tables and
+// the ratio spilled to disk, the memory used by the TempTable engine (MySQL 8)
+// and, when the beat runs on the server host, the free space of the tmpdir,
+// under mysql.tmp
+type tmpTables struct {
+	config tmpTablesConfig
+	rates  *rateTracker
+}
+
+func newTmpTables(cfg *common.Config) (Module, error) {
+	c := tmpTablesConfig{}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	return &tmpTables{config: c, rates: newRateTracker()}, nil
+}
+
+// Fetch returns a single event with the temporary tables usage
+func (m *tmpTables) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+	status, err := QueryVariables(ctx, db, "SHOW GLOBAL STATUS LIKE 'Created_tmp%'")
+	if err != nil {
+		return nil, err
+	}
+
+	fields := common.MapStr{}
+	for variable, field := range tmpTablesFields {
+		value, exists := status[variable]
+		if !exists {
+			continue
+		}
+
+		n := parseInt(value)
+		fields.Put(field.name, n)
+		if field.counter {
+			m.rates.putRate(fields, field.name, float64(n), now)
+		}
+	}
+
+	// The ratio of the temporary tables created during the interval on disk
+	tables, _ := fields.GetValue("created.tables" + rateSuffix)
+	diskTables, _ := fields.GetValue("created.disk_tables" + rateSuffix)
+	if tables, ok := tables.(float64); ok && tables > 0 {
+		if diskTables, ok := diskTables.(float64); ok {
+			fields.Put("disk_ratio", diskTables/tables)
+		}
+	}
+
+	variables, err := QueryVariables(ctx, db, "SHOW GLOBAL VARIABLES WHERE Variable_name IN ('tmpdir', 'tmp_table_size', 'max_heap_table_size', 'temptable_max_ram')")
+	if err != nil {
+		return nil, err
+	}
+
+	fields.Put("tmp_table_size", parseInt(variables["tmp_table_size"]))
+	fields.Put("max_heap_table_size", parseInt(variables["max_heap_table_size"]))
+
+	// The TempTable engine and its instrumentation are only available from MySQL 8.0
+	if maxRAM, exists := variables["temptable_max_ram"]; exists {
+		fields.Put("temptable.max_ram", parseInt(maxRAM))
+
+		rows, err := QueryRows(ctx, db, "SELECT SUM(CURRENT_NUMBER_OF_BYTES_USED) AS CURRENT_BYTES, SUM(HIGH_NUMBER_OF_BYTES_USED) AS HIGH_BYTES "+
+			"FROM performance_schema.memory_summary_global_by_event_name WHERE EVENT_NAME LIKE 'memory/temptable/%'")
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) > 0 {
+			fields.Put("temptable.memory.current_bytes", parseInt(rows[0]["CURRENT_BYTES"]))
+			fields.Put("temptable.memory.high_bytes", parseInt(rows[0]["HIGH_BYTES"]))
+		}
+	}
+
+	if m.config.Tmpdir {
+		// tmpdir can list several directories, the first one is checked
+		dirs := strings.FieldsFunc(variables["tmpdir"], func(r rune) bool { return r == ':' || r == ';' })
+		if len(dirs) > 0 {
+			fields.Put("tmpdir.path", dirs[0])
+			if free, total, err := diskSpace(dirs[0]); err == nil {
+				fields.Put("tmpdir.free_bytes", free)
+				fields.Put("tmpdir.total_bytes", total)
+			}
+		}
+	}
+
+	return []common.MapStr{{"mysql": common.MapStr{"tmp": fields}}}, nil
+}
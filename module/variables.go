@@ -0,0 +1,83 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("variables", newVariables)
+}
+
+type variablesConfig struct {
+	Include []string `config:"include"`
+}
+
+// variables snapshots the global variables matching the include patterns
+// under mysql.variables.values, and reports the variables whose value changed
+// since the previous snapshot
+type variables struct {
+	config   variablesConfig
+	previous map[string]string
+}
+
+func newVariables(cfg *common.Config) (Module, error) {
+	c := variablesConfig{
+		Include: []string{"*"},
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	if err := checkPatterns(c.Include); err != nil {
+		return nil, err
+	}
+
+	return &variables{config: c}, nil
+}
+
+// Fetch returns a single event with the snapshot and the changed variables
+func (m *variables) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	all, err := QueryVariables(ctx, db, "SHOW GLOBAL VARIABLES")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := map[string]string{}
+	values := common.MapStr{}
+	for name, value := range all {
+		if matchAny(m.config.Include, name) {
+			snapshot[name] = value
+			values[name] = ParseValue(value)
+		}
+	}
+
+	fields := common.MapStr{
+		"values": values,
+	}
+
+	// The first snapshot has nothing to be compared with
+	if m.previous != nil {
+		var changed []string
+		previous := common.MapStr{}
+		for name, value := range snapshot {
+			if oldValue, exists := m.previous[name]; exists && oldValue != value {
+				changed = append(changed, name)
+				previous[name] = ParseValue(oldValue)
+			}
+		}
+		sort.Strings(changed)
+
+		fields["changed"] = changed
+		fields["changed_count"] = len(changed)
+		if len(changed) > 0 {
+			fields["previous"] = previous
+		}
+	}
+	m.previous = snapshot
+
+	return []common.MapStr{{"mysql": common.MapStr{"variables": fields}}}, nil
+}
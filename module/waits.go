@@ -0,0 +1,150 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/common"
+)
+
+func init() {
+	Register("waits", newWaits)
+}
+
+type waitsConfig struct {
+	Include []string `config:"include"`
+}
+
+// waitCounters are the cumulated counters of a wait event
+type waitCounters struct {
+	count, wait uint64
+}
+
+// waitClass sums the interval counters of the events of a wait class
+type waitClass struct {
+	interval waitCounters
+	top      string
+	topWait  uint64
+}
+
+// waits reports the wait time of every wait class (the first three levels of
+// the event names, such as wait/io/file or wait/synch/mutex) over the last
+// interval, from performance_schema.events_waits_summary_global_by_event_name,
+// under mysql.wait
+type waits struct {
+	config       waitsConfig
+	previous     map[string]waitCounters
+	previousTime time.Time
+}
+
+func newWaits(cfg *common.Config) (Module, error) {
+	c := waitsConfig{
+		Include: []string{"wait/io/*", "wait/lock/*", "wait/synch/*"},
+	}
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+
+	if err := checkPatterns(c.Include); err != nil {
+		return nil, err
+	}
+
+	return &waits{config: c}, nil
+}
+
+// Fetch returns one event per included wait class with waits during the last
+// interval, there are no events on the first fetch since the interval deltas
+// can't be computed yet
+func (m *waits) Fetch(ctx context.Context, db *sql.DB) ([]common.MapStr, error) {
+	now := time.Now()
+	rows, err := QueryRows(ctx, db, "SELECT EVENT_NAME, COUNT_STAR, SUM_TIMER_WAIT "+
+		"FROM performance_schema.events_waits_summary_global_by_event_name WHERE COUNT_STAR > 0")
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]waitCounters, len(rows))
+	classes := map[string]*waitClass{}
+	var classNames []string
+	for _, row := range rows {
+		name := row["EVENT_NAME"]
+		class := waitClassName(name)
+		if !matchAny(m.config.Include, class) {
+			continue
+		}
+
+		counters := waitCounters{count: parseUint(row["COUNT_STAR"]), wait: parseUint(row["SUM_TIMER_WAIT"])}
+		current[name] = counters
+
+		if m.previous == nil {
+			continue
+		}
+
+		// An event that is new or was reset counts from zero
+		previous, exists := m.previous[name]
+		if !exists || counters.count < previous.count || counters.wait < previous.wait {
+			previous = waitCounters{}
+		}
+
+		interval := waitCounters{count: counters.count - previous.count, wait: counters.wait - previous.wait}
+		if interval.count == 0 {
+			continue
+		}
+
+		c, exists := classes[class]
+		if !exists {
+			c = &waitClass{}
+			classes[class] = c
+			classNames = append(classNames, class)
+		}
+
+		c.interval.count += interval.count
+		c.interval.wait += interval.wait
+		if interval.wait >= c.topWait {
+			c.top, c.topWait = name, interval.wait
+		}
+	}
+
+	isFirst := m.previous == nil
+	seconds := now.Sub(m.previousTime).Seconds()
+	m.previous, m.previousTime = current, now
+	if isFirst || seconds <= 0 {
+		return nil, nil
+	}
+
+	var events []common.MapStr
+	for _, name := range classNames {
+		c := classes[name]
+
+		// Timer waits are in picoseconds
+		waitUs := c.interval.wait / 1000000
+		events = append(events, common.MapStr{
+			"mysql": common.MapStr{
+				"wait": common.MapStr{
+					"class":                name,
+					"count":                c.interval.count,
+					"wait_us":              waitUs,
+					"wait_us" + rateSuffix: float64(waitUs) / seconds,
+					"top_event": common.MapStr{
+						"name":    c.top,
+						"wait_us": c.topWait / 1000000,
+					},
+				},
+			},
+		})
+	}
+
+	return events, nil
+}
+
+// waitClassName returns the class of a wait event, its first three levels
+func waitClassName(name string) string {
+	parts := strings.SplitN(name, "/", 4)
+	if len(parts) > 3 {
+		parts = parts[:3]
+	}
+
+	return strings.Join(parts, "/")
+}